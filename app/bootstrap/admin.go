@@ -0,0 +1,62 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	tenant_persistence "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/infrastructure/persistence"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/outbox"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/security"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateAdmin da de alta un administrador en un tenant existente. Como
+// RebuildProjection, solo necesita la base de datos: user.created queda en el
+// outbox y lo publica el relay.
+func CreateAdmin(ctx context.Context, cmd commands.CreateAdminCommand) (uuid.UUID, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	logger, err := initLogger()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to initialize logger: %w", err)
+	}
+	defer logger.Sync()
+
+	db, connections, err := initDatabase(cfg, logger)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer connections.Close()
+
+	// El catálogo de tenants vive en la base de datos principal
+	if _, err := tenant_persistence.NewGormTenantRepository(db).FindByID(ctx, cmd.TenantID); err != nil {
+		return uuid.Nil, err
+	}
+
+	useCase := commands.NewCreateAdminUseCase(
+		newUserRepository(cfg, db),
+		outbox.NewGormOutboxEventBus(db),
+		security.NewBcryptHasher(),
+		transaction.NewTenantGormTransactionManager(connections),
+	)
+
+	response, err := useCase.Execute(shared_ports.WithTenantID(ctx, cmd.TenantID), cmd)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	logger.Info("admin created",
+		zap.String("tenant", cmd.TenantID),
+		zap.String("user_id", response.UserID.String()),
+		zap.String("role", string(cmd.Role)),
+	)
+	return response.UserID, nil
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"time"
 
+	auth_routes "github.com/carloscacb333/go-hexagonal/app/contexts/auth/infrastructure/http/routes"
+	flag_routes "github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/infrastructure/http/routes"
+	tenant_routes "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/infrastructure/http/routes"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/infrastructure/http/routes"
 	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
 	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
 	shared_persistence "github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/recover"
@@ -38,16 +42,23 @@ func NewApplication() (*App, error) {
 	}
 
 	// 3. Conectar base de datos
-	db, err := initDatabase(cfg, logger)
+	db, connections, err := initDatabase(cfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
+	controlPlaneDB, err := shared_persistence.ConnectControlPlaneDatabase(&cfg.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize control plane database: %w", err)
+	}
+
 	// 4. Construir contenedor de dependencias
 	container, err := NewContainerBuilder().
 		WithConfig(cfg).
 		WithLogger(logger).
 		WithDatabase(db).
+		WithControlPlaneDatabase(controlPlaneDB).
+		WithConnectionResolver(connections).
 		Build()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build container: %w", err)
@@ -71,21 +82,92 @@ func initLogger() (*zap.Logger, error) {
 	return logger, nil
 }
 
-func initDatabase(cfg *config.Config, logger *zap.Logger) (*gorm.DB, error) {
+// initDatabase abre la conexión principal y el resolver de conexiones por
+// tenant, y comprueba las migraciones en cada base de datos o esquema de
+// tenant. En desarrollo antes las aplica, con el rol de sistema.
+func initDatabase(cfg *config.Config, logger *zap.Logger) (*gorm.DB, shared_persistence.TenantConnectionResolver, error) {
+	ctx := context.Background()
+
+	// En desarrollo el esquema se actualiza solo; en el resto de entornos hay
+	// que ejecutar `migrate up` antes de desplegar
+	if cfg.App.Environment == "development" {
+		if err := migrateDatabases(ctx, cfg, logger); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	db, err := shared_persistence.ConnectDatabase(&cfg.DB)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Ejecutar migraciones en desarrollo
-	if cfg.App.Environment == "development" {
-		logger.Info("running database migrations")
-		if err := shared_persistence.AutoMigrate(db, logger); err != nil {
-			return nil, fmt.Errorf("failed to run migrations: %w", err)
+	connections, err := shared_persistence.NewTenantConnectionResolver(cfg, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	databases, err := connections.Connections(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// No se sirve nada con un esquema distinto al que espera el código
+	for tenantID, tenantDB := range databases {
+		if err := checkSchemaVersion(ctx, tenantDB); err != nil {
+			if tenantID != "" {
+				err = fmt.Errorf("tenant %s: %w", tenantID, err)
+			}
+			return nil, nil, err
 		}
 	}
 
-	return db, nil
+	return db, connections, nil
+}
+
+func migrateDatabases(ctx context.Context, cfg *config.Config, logger *zap.Logger) error {
+	migrators, closeMigrators, err := openMigrators(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeMigrators()
+
+	logger.Info("running database migrations")
+	for tenantID, migrator := range migrators {
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			if tenantID != "" {
+				return fmt.Errorf("tenant %s: failed to run migrations: %w", tenantID, err)
+			}
+			return fmt.Errorf("failed to run migrations: %w", err)
+		}
+		for _, migration := range applied {
+			logger.Info("migration applied",
+				zap.String("tenant", tenantID),
+				zap.Int("version", migration.Version),
+				zap.String("name", migration.Name),
+			)
+		}
+	}
+
+	return nil
+}
+
+func checkSchemaVersion(ctx context.Context, db *gorm.DB) error {
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	return migrator.CheckVersion(ctx)
+}
+
+// publicRoutes admiten peticiones sin bearer token; el resto exige autenticación.
+var publicRoutes = []middleware.PublicRoute{
+	{Method: fiber.MethodGet, Path: "/health"},
+	{Method: fiber.MethodGet, Path: "/ready"},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/"},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/login"},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/refresh"},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/logout"},
 }
 
 func createHTTPServer(container *Container, logger *zap.Logger) *fiber.App {
@@ -99,6 +181,12 @@ func createHTTPServer(container *Container, logger *zap.Logger) *fiber.App {
 		ReadTimeout:           10 * time.Second,
 		WriteTimeout:          10 * time.Second,
 		IdleTimeout:           120 * time.Second,
+
+		// Detrás de nginx la IP del cliente llega en X-Real-IP; solo se acepta
+		// si la conexión viene de un proxy de confianza
+		ProxyHeader:             "X-Real-IP",
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          container.GetConfig().API.TrustedProxies,
 	})
 
 	app.Use(recover.New(recover.Config{
@@ -106,15 +194,30 @@ func createHTTPServer(container *Container, logger *zap.Logger) *fiber.App {
 	}))
 	app.Use(cors.New(cors.Config{
 		AllowOrigins: "*",
-		AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
+		AllowMethods: "GET,POST,PUT,PATCH,DELETE,OPTIONS",
 		AllowHeaders: "Origin, Content-Type, Accept, Authorization, X-Request-ID, X-Tenant-ID",
 	}))
 	app.Use(middleware.CorrelationIDMiddleware())
-	app.Use(middleware.TenantMiddleware())
-	app.Use(middleware.LoggerMiddleware(logger))
+	app.Use(middleware.AuthMiddleware(container.GetTokenService(), publicRoutes...))
 
+	// Los health checks no dependen de un tenant
 	registerHealthChecks(app, container)
 
+	app.Use(middleware.TenantMiddleware(container.GetTenantLookup()))
+	app.Use(middleware.LoggerMiddleware(logger))
+
+	// La administración de tenants y feature flags opera sobre la base de datos
+	// de control, no sobre la del tenant del token
+	registerControlPlaneRoutes(app, container)
+
+	// Los casos de uso de auth abren sus propias transacciones: la revocación
+	// de un refresh token reutilizado debe confirmarse aunque la petición
+	// responda 401
+	app.Use(middleware.TenantContextMiddleware())
+	registerAuthRoutes(app, container)
+
+	// El resto de rutas abre la transacción del tenant (TenantScopeMiddleware)
+	// en su propia cadena, después del rate limiter
 	registerRoutes(app, container)
 
 	return app
@@ -150,21 +253,76 @@ func registerHealthChecks(app *fiber.App, container *Container) {
 			return c.Status(e.Code).JSON(e)
 		}
 
+		// Verificar broker: el publisher reconecta en segundo plano
+		if checker, ok := container.GetEventBus().(shared_ports.HealthChecker); ok {
+			if err := checker.Healthy(); err != nil {
+				e := shared_exceptions.NewServiceUnavailableError(
+					"broker_unavailable",
+					"message broker connection lost",
+				)
+				return c.Status(e.Code).JSON(e)
+			}
+		}
+
 		return c.JSON(fiber.Map{
 			"status":   "ready",
 			"database": "connected",
+			"broker":   "connected",
 		})
 	})
 }
 
+func registerControlPlaneRoutes(app *fiber.App, container *Container) {
+	api := app.Group("/api")
+
+	tenant_routes.RegisterTenantRoutes(
+		api,
+		container.GetRateLimiter(),
+		container.GetCreateTenantUseCase(),
+		container.GetGetTenantUseCase(),
+		container.GetListTenantsUseCase(),
+		container.GetUpdateTenantUseCase(),
+		container.GetChangeTenantStatusUseCase(),
+	)
+
+	flag_routes.RegisterFeatureFlagRoutes(
+		api,
+		container.GetRateLimiter(),
+		container.GetCreateFeatureFlagUseCase(),
+		container.GetGetFeatureFlagUseCase(),
+		container.GetListFeatureFlagsUseCase(),
+		container.GetUpdateFeatureFlagUseCase(),
+		container.GetSetFeatureFlagOverrideUseCase(),
+		container.GetRemoveFeatureFlagOverrideUseCase(),
+	)
+}
+
 func registerRoutes(app *fiber.App, container *Container) {
 	api := app.Group("/api")
 
+	scope := middleware.TenantScopeMiddleware(container.GetTransactionManager())
+
 	routes.RegisterUserRoutes(
 		api,
-		container.GetConfig(),
+		container.GetRateLimiter(),
+		scope,
 		container.GetCreateUserUseCase(),
 		container.GetGetUserUseCase(),
+		container.GetUpdateUserUseCase(),
+		container.GetDeleteUserUseCase(),
+		container.GetListUsersUseCase(),
+	)
+}
+
+func registerAuthRoutes(app *fiber.App, container *Container) {
+	api := app.Group("/api")
+
+	auth_routes.RegisterAuthRoutes(
+		api,
+		container.GetRateLimiter(),
+		container.GetLoginUseCase(),
+		container.GetRefreshTokenUseCase(),
+		container.GetLogoutUseCase(),
 	)
 }
 
@@ -206,6 +364,11 @@ func (a *App) StartEventConsumers(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) StartOutboxRelay(ctx context.Context) error {
+	a.logger.Info("starting outbox relay")
+	return a.container.GetOutboxRelay().Start(ctx)
+}
+
 func (a *App) Shutdown(ctx context.Context) error {
 	a.logger.Info("shutting down application")
 
@@ -225,3 +388,7 @@ func (a *App) Shutdown(ctx context.Context) error {
 func (a *App) GetLogger() *zap.Logger {
 	return a.logger
 }
+
+func (a *App) GetConfig() *config.Config {
+	return a.container.GetConfig()
+}
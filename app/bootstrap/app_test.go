@@ -0,0 +1,193 @@
+package bootstrap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	auth_commands "github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/ratelimit"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/security"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/tenancy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type journalKey struct{}
+
+// journal acumula las escrituras de una transacción; solo se aplican si la
+// transacción se confirma.
+type journal struct {
+	writes []func()
+}
+
+// journalingTxManager imita la semántica de GormTransactionManager: las
+// transacciones anidadas se unen a la exterior y un error descarta todas sus
+// escrituras.
+type journalingTxManager struct {
+	mu     sync.Mutex
+	opened int
+}
+
+func (m *journalingTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(journalKey{}).(*journal); ok {
+		return fn(ctx)
+	}
+
+	m.mu.Lock()
+	m.opened++
+	m.mu.Unlock()
+
+	j := &journal{}
+	if err := fn(context.WithValue(ctx, journalKey{}, j)); err != nil {
+		return err
+	}
+	for _, write := range j.writes {
+		write()
+	}
+	return nil
+}
+
+type transactionalRefreshTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]entities.RefreshToken
+}
+
+func (r *transactionalRefreshTokenRepository) write(ctx context.Context, fn func()) {
+	apply := func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		fn()
+	}
+
+	if j, ok := ctx.Value(journalKey{}).(*journal); ok {
+		j.writes = append(j.writes, apply)
+		return
+	}
+	apply()
+}
+
+func (r *transactionalRefreshTokenRepository) Save(ctx context.Context, token *entities.RefreshToken) error {
+	saved := *token
+	r.write(ctx, func() { r.tokens[saved.TokenHash] = saved })
+	return nil
+}
+
+func (r *transactionalRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, exceptions.ErrInvalidRefreshToken
+	}
+	return &token, nil
+}
+
+func (r *transactionalRefreshTokenRepository) Revoke(ctx context.Context, token *entities.RefreshToken) error {
+	hash := token.TokenHash
+	r.write(ctx, func() {
+		stored := r.tokens[hash]
+		stored.Revoke()
+		r.tokens[hash] = stored
+	})
+	return nil
+}
+
+func (r *transactionalRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	r.write(ctx, func() {
+		for hash, token := range r.tokens {
+			if token.FamilyID == familyID {
+				token.Revoke()
+				r.tokens[hash] = token
+			}
+		}
+	})
+	return nil
+}
+
+// newTestContainer devuelve un contenedor con solo lo que createHTTPServer
+// necesita para montar las rutas y servir las de auth.
+func newAuthTestContainer(t *testing.T, tokens *transactionalRefreshTokenRepository) *Container {
+	return newTestContainer(t, tokens, ratelimit.NewMemoryStore())
+}
+
+func newTestContainer(t *testing.T, tokens *transactionalRefreshTokenRepository, store ratelimit.Store) *Container {
+	tokenService, err := security.NewJWTTokenService(&config.AuthConfig{
+		JWTAlgorithm:   "HS256",
+		JWTSecret:      "test-secret-with-at-least-32-bytes!",
+		JWTIssuer:      "go-hexagonal",
+		AccessTokenTTL: time.Minute,
+	})
+	require.NoError(t, err)
+
+	txManager := &journalingTxManager{}
+	rateLimit := &config.RateLimitConfig{Store: config.RateLimitStoreMemory, Window: time.Minute}
+
+	return &Container{
+		config:       &config.Config{RateLimit: *rateLimit},
+		tokenService: tokenService,
+		txManager:    txManager,
+		tenantLookup: tenancy.NewInMemoryTenantLookup(shared_ports.TenantActive),
+		rateLimiter:  middleware.NewRateLimiter(store, rateLimit, zap.NewNop()),
+		refreshTokenUseCase: auth_commands.NewRefreshTokenUseCase(
+			nil, tokens, tokenService, txManager, time.Hour,
+		),
+	}
+}
+
+func TestRefreshTokenReuse_RevocationSurvivesTheRequest(t *testing.T) {
+	tokens := &transactionalRefreshTokenRepository{tokens: map[string]entities.RefreshToken{}}
+	app := createHTTPServer(newAuthTestContainer(t, tokens), zap.NewNop())
+
+	// Familia con un token ya rotado y su sucesor todavía válido
+	used, plain, err := entities.NewRefreshToken("tenant-123", uuid.New(), time.Hour)
+	require.NoError(t, err)
+	next, _, err := used.Rotate(time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, tokens.Save(context.Background(), used))
+	require.NoError(t, tokens.Save(context.Background(), next))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", strings.NewReader(`{"refresh_token":"`+plain+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-Id", "tenant-123")
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	stored, err := tokens.FindByHash(context.Background(), next.TokenHash)
+	require.NoError(t, err)
+	assert.True(t, stored.IsRevoked(), "the reused token's family must stay revoked after the 401")
+}
+
+type denyingStore struct{}
+
+func (denyingStore) Allow(ctx context.Context, key string, policy ratelimit.Policy) (ratelimit.Decision, error) {
+	return ratelimit.Decision{Limit: policy.Limit, RetryAfter: time.Second}, nil
+}
+
+func TestRateLimitedRequest_DoesNotOpenTransaction(t *testing.T) {
+	container := newTestContainer(t, nil, denyingStore{})
+	app := createHTTPServer(container, zap.NewNop())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-Id", "tenant-123")
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Zero(t, container.txManager.(*journalingTxManager).opened)
+}
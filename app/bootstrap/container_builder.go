@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	shared_persistence "github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -13,6 +14,10 @@ type ContainerBuilder struct {
 	logger *zap.Logger
 	db     *gorm.DB
 	errors []error
+
+	controlPlaneDB *gorm.DB
+
+	connections shared_persistence.TenantConnectionResolver
 }
 
 func NewContainerBuilder() *ContainerBuilder {
@@ -48,6 +53,28 @@ func (b *ContainerBuilder) WithDatabase(db *gorm.DB) *ContainerBuilder {
 	return b
 }
 
+// WithControlPlaneDatabase recibe el pool de las lecturas de control; debe ser
+// distinto del de WithDatabase.
+func (b *ContainerBuilder) WithControlPlaneDatabase(db *gorm.DB) *ContainerBuilder {
+	if db == nil {
+		b.errors = append(b.errors, fmt.Errorf("control plane database cannot be nil"))
+		return b
+	}
+	b.controlPlaneDB = db
+	return b
+}
+
+// WithConnectionResolver es opcional: sin él todos los tenants comparten la
+// base de datos de WithDatabase.
+func (b *ContainerBuilder) WithConnectionResolver(connections shared_persistence.TenantConnectionResolver) *ContainerBuilder {
+	if connections == nil {
+		b.errors = append(b.errors, fmt.Errorf("connection resolver cannot be nil"))
+		return b
+	}
+	b.connections = connections
+	return b
+}
+
 func (b *ContainerBuilder) Build() (*Container, error) {
 	if len(b.errors) > 0 {
 		return nil, fmt.Errorf("container build failed with %d errors: %v", len(b.errors), b.errors)
@@ -62,6 +89,13 @@ func (b *ContainerBuilder) Build() (*Container, error) {
 	if b.db == nil {
 		return nil, fmt.Errorf("database is required")
 	}
+	if b.controlPlaneDB == nil {
+		return nil, fmt.Errorf("control plane database is required")
+	}
+
+	if b.connections == nil {
+		b.connections = shared_persistence.NewSharedConnectionResolver(b.db)
+	}
 
-	return NewContainer(b.config, b.logger, b.db)
+	return NewContainer(b.config, b.logger, b.db, b.controlPlaneDB, b.connections)
 }
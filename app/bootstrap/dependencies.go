@@ -3,6 +3,21 @@ package bootstrap
 import (
 	"fmt"
 
+	auth_commands "github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	auth_ports "github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/ports"
+	auth_persistence "github.com/carloscacb333/go-hexagonal/app/contexts/auth/infrastructure/persistence"
+	flag_commands "github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/commands"
+	flag_queries "github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/queries"
+	flag_ports "github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+	flag_cache "github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/infrastructure/cache"
+	flag_persistence "github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/infrastructure/persistence"
+	tenant_commands "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/commands"
+	tenant_notifications "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/notifications"
+	tenant_queries "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/queries"
+	tenant_ports "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/ports"
+	tenant_cache "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/infrastructure/cache"
+	tenant_consumers "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/infrastructure/messaging/consumers"
+	tenant_persistence "github.com/carloscacb333/go-hexagonal/app/contexts/tenants/infrastructure/persistence"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/notifications"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/projections"
@@ -12,9 +27,15 @@ import (
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/infrastructure/persistence"
 	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/eventstore"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/inbox"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/outbox"
 	shared_persistence "github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/rabbitmq"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/ratelimit"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/security"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -25,32 +46,102 @@ type Container struct {
 	db     *gorm.DB
 	hasher shared_ports.Hasher
 
-	// Event bus
-	eventBus shared_ports.EventBus
+	// Pool de las lecturas de control hechas en mitad de una petición
+	controlPlaneDB *gorm.DB
+
+	// Conexión de cada tenant según el modo de tenancy
+	connections shared_persistence.TenantConnectionResolver
+
+	// Autenticación
+	tokenService shared_ports.TokenService
+
+	// Transacciones
+	txManager shared_ports.TransactionManager
+
+	// Rate limiting compartido por todas las rutas
+	rateLimiter *middleware.RateLimiter
+
+	// Event bus: eventBus publica en RabbitMQ, outboxEventBus guarda en el outbox
+	eventBus       shared_ports.EventBus
+	outboxEventBus shared_ports.EventBus
+	outboxRelay    *outbox.Relay
 
 	// Repositorios
-	idempotencyRepository shared_ports.IdempotencyRepository
-	userRepository        ports.UserRepository
-	userReadRepository    ports.UserReadRepository
+	idempotencyRepository  shared_ports.IdempotencyRepository
+	inboxRepository        shared_ports.InboxRepository
+	userRepository         ports.UserRepository
+	userReadRepository     ports.UserReadRepository
+	refreshTokenRepository auth_ports.RefreshTokenRepository
+	tenantRepository       tenant_ports.TenantRepository
+	featureFlagRepository  flag_ports.FeatureFlagRepository
+
+	// Estado de los tenants, cacheado para TenantMiddleware
+	tenantLookup shared_ports.TenantLookup
+
+	// Evaluación de feature flags, cacheada
+	featureFlags shared_ports.FeatureFlags
 
 	// Casos de uso
-	createUserUseCase *commands.CreateUserUseCase
-	getUserUseCase    *queries.GetUserUseCase
+	createUserUseCase   *commands.CreateUserUseCase
+	updateUserUseCase   *commands.UpdateUserUseCase
+	deleteUserUseCase   *commands.DeleteUserUseCase
+	getUserUseCase      *queries.GetUserUseCase
+	listUsersUseCase    *queries.ListUsersUseCase
+	loginUseCase        *auth_commands.LoginUseCase
+	refreshTokenUseCase *auth_commands.RefreshTokenUseCase
+	logoutUseCase       *auth_commands.LogoutUseCase
+
+	createTenantUseCase       *tenant_commands.CreateTenantUseCase
+	updateTenantUseCase       *tenant_commands.UpdateTenantUseCase
+	changeTenantStatusUseCase *tenant_commands.ChangeTenantStatusUseCase
+	getTenantUseCase          *tenant_queries.GetTenantUseCase
+	listTenantsUseCase        *tenant_queries.ListTenantsUseCase
+
+	createFeatureFlagUseCase         *flag_commands.CreateFeatureFlagUseCase
+	updateFeatureFlagUseCase         *flag_commands.UpdateFeatureFlagUseCase
+	setFeatureFlagOverrideUseCase    *flag_commands.SetFeatureFlagOverrideUseCase
+	removeFeatureFlagOverrideUseCase *flag_commands.RemoveFeatureFlagOverrideUseCase
+	getFeatureFlagUseCase            *flag_queries.GetFeatureFlagUseCase
+	listFeatureFlagsUseCase          *flag_queries.ListFeatureFlagsUseCase
 
 	// Projections
 	userCreatedHandler      *projections.UserCreatedHandler
+	userUpdatedHandler      *projections.UserUpdatedHandler
+	userDeletedHandler      *projections.UserDeletedHandler
+	userErasedHandler       *projections.UserErasedHandler
 	userNotificationHandler *notifications.UserNotificationHandler
 
+	tenantNotificationHandler *tenant_notifications.TenantNotificationHandler
+
 	// Consumidores de eventos
 	eventConsumers []shared_ports.EventConsumer
 }
 
-func NewContainer(cfg *config.Config, logger *zap.Logger, db *gorm.DB) (*Container, error) {
+func NewContainer(
+	cfg *config.Config,
+	logger *zap.Logger,
+	db *gorm.DB,
+	controlPlaneDB *gorm.DB,
+	connections shared_persistence.TenantConnectionResolver,
+) (*Container, error) {
 	container := &Container{
-		config: cfg,
-		logger: logger,
-		db:     db,
-		hasher: security.NewBcryptHasher(),
+		config:         cfg,
+		logger:         logger,
+		db:             db,
+		controlPlaneDB: controlPlaneDB,
+		connections:    connections,
+		hasher:         security.NewBcryptHasher(),
+		txManager:      transaction.NewTenantGormTransactionManager(connections),
+	}
+
+	tokenService, err := security.NewJWTTokenService(&cfg.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize token service: %w", err)
+	}
+	container.tokenService = tokenService
+
+	if err := container.initRateLimiter(); err != nil {
+		return nil, fmt.Errorf("failed to initialize rate limiter: %w", err)
 	}
 
 	if err := container.initEventBus(); err != nil {
@@ -70,54 +161,175 @@ func NewContainer(cfg *config.Config, logger *zap.Logger, db *gorm.DB) (*Contain
 
 func (c *Container) initEventBus() error {
 
-	eventBus, err := rabbitmq.NewRabbitMQEventBus(&c.config.RabbitMQ)
+	eventBus, err := rabbitmq.NewRabbitMQEventBus(&c.config.RabbitMQ, c.logger)
 	if err != nil {
 		return fmt.Errorf("failed to create event bus: %w", err)
 	}
 	c.eventBus = eventBus
+	c.outboxEventBus = outbox.NewGormOutboxEventBus(c.db)
+	c.outboxRelay = outbox.NewRelay(c.connections, c.eventBus, &c.config.Outbox, c.logger)
+
+	return nil
+}
+
+func (c *Container) initRateLimiter() error {
+	if c.config.RateLimit.Window <= 0 {
+		return fmt.Errorf("rate limit window must be positive")
+	}
 
+	var store ratelimit.Store
+	switch c.config.RateLimit.Store {
+	case config.RateLimitStoreMemory, "":
+		store = ratelimit.NewMemoryStore()
+	case config.RateLimitStorePostgres:
+		// Los cupos viven en la base de datos principal, compartida por las réplicas
+		store = ratelimit.NewPostgresStore(c.controlPlaneDB)
+	default:
+		return fmt.Errorf("unknown rate limit store %q", c.config.RateLimit.Store)
+	}
+
+	c.rateLimiter = middleware.NewRateLimiter(store, &c.config.RateLimit, c.logger)
 	return nil
 }
 
 func (c *Container) initRepositories() {
 	c.idempotencyRepository = shared_persistence.NewGormIdempotencyRepository(c.db)
-	c.userRepository = persistence.NewGormUserRepository(c.db)
+	c.inboxRepository = inbox.NewGormInboxRepository(c.db)
+	c.userRepository = newUserRepository(c.config, c.db)
 	c.userReadRepository = persistence.NewGormUserReadRepository(c.db)
+	c.refreshTokenRepository = auth_persistence.NewGormRefreshTokenRepository(c.db)
+
+	// El catálogo de tenants vive siempre en la base de datos principal
+	c.tenantRepository = tenant_persistence.NewGormTenantRepository(c.db)
+	c.featureFlagRepository = flag_persistence.NewGormFeatureFlagRepository(c.db)
+
+	// Las cachés leen del pool de control: se consultan con la petición ya en
+	// marcha y no deben esperar conexiones del pool principal
+	c.tenantLookup = tenant_cache.NewCachedTenantLookup(
+		tenant_persistence.NewGormTenantRepository(c.controlPlaneDB),
+		c.config.Tenancy.CacheTTL,
+	)
+	c.featureFlags = flag_cache.NewCachedFeatureFlags(
+		flag_persistence.NewGormFeatureFlagRepository(c.controlPlaneDB),
+		c.config.FeatureFlags.CacheTTL,
+	)
+}
+
+func newUserRepository(cfg *config.Config, db *gorm.DB) ports.UserRepository {
+	if cfg.Events.UserPersistence == config.UserPersistenceEventSourced {
+		store := eventstore.NewGormEventStore(db)
+		return persistence.NewEventSourcedUserRepository(db, store, cfg.Events.SnapshotEvery)
+	}
 
+	return persistence.NewGormUserRepository(db)
 }
 
 func (c *Container) initUseCases() {
 	c.createUserUseCase = commands.NewCreateUserUseCase(
 		c.userRepository,
 		c.idempotencyRepository,
-		c.eventBus,
+		c.outboxEventBus,
 		c.hasher,
+		c.txManager,
+		c.featureFlags,
+	)
+	c.updateUserUseCase = commands.NewUpdateUserUseCase(
+		c.userRepository,
+		c.outboxEventBus,
+		c.txManager,
+		c.featureFlags,
+	)
+	c.deleteUserUseCase = commands.NewDeleteUserUseCase(
+		c.userRepository,
+		c.outboxEventBus,
+		outbox.NewGormOutboxRedactor(c.db),
+		c.txManager,
 	)
 	c.getUserUseCase = queries.NewGetUserUseCase(c.userReadRepository)
+	c.listUsersUseCase = queries.NewListUsersUseCase(c.userReadRepository)
 
+	c.loginUseCase = auth_commands.NewLoginUseCase(
+		c.userRepository,
+		c.refreshTokenRepository,
+		c.hasher,
+		c.tokenService,
+		c.txManager,
+		c.config.Auth.RefreshTokenTTL,
+	)
+	c.refreshTokenUseCase = auth_commands.NewRefreshTokenUseCase(
+		c.userRepository,
+		c.refreshTokenRepository,
+		c.tokenService,
+		c.txManager,
+		c.config.Auth.RefreshTokenTTL,
+	)
+	c.logoutUseCase = auth_commands.NewLogoutUseCase(c.refreshTokenRepository, c.txManager)
+
+	// Sin tenant en el contexto, txManager abre la transacción en la base de
+	// datos principal, donde están el catálogo y su outbox
+	c.createTenantUseCase = tenant_commands.NewCreateTenantUseCase(
+		c.tenantRepository,
+		c.outboxEventBus,
+		c.txManager,
+	)
+	c.updateTenantUseCase = tenant_commands.NewUpdateTenantUseCase(c.tenantRepository, c.txManager)
+	c.changeTenantStatusUseCase = tenant_commands.NewChangeTenantStatusUseCase(
+		c.tenantRepository,
+		c.outboxEventBus,
+		c.txManager,
+	)
+	c.getTenantUseCase = tenant_queries.NewGetTenantUseCase(c.tenantRepository)
+	c.listTenantsUseCase = tenant_queries.NewListTenantsUseCase(c.tenantRepository)
+
+	c.createFeatureFlagUseCase = flag_commands.NewCreateFeatureFlagUseCase(c.featureFlagRepository, c.txManager)
+	c.updateFeatureFlagUseCase = flag_commands.NewUpdateFeatureFlagUseCase(c.featureFlagRepository, c.txManager)
+	c.setFeatureFlagOverrideUseCase = flag_commands.NewSetFeatureFlagOverrideUseCase(c.featureFlagRepository, c.txManager)
+	c.removeFeatureFlagOverrideUseCase = flag_commands.NewRemoveFeatureFlagOverrideUseCase(c.featureFlagRepository, c.txManager)
+	c.getFeatureFlagUseCase = flag_queries.NewGetFeatureFlagUseCase(c.featureFlagRepository)
+	c.listFeatureFlagsUseCase = flag_queries.NewListFeatureFlagsUseCase(c.featureFlagRepository)
 }
 
 func (c *Container) initHandlers() {
 	c.userCreatedHandler = projections.NewUserCreatedHandler(c.userReadRepository)
+	c.userUpdatedHandler = projections.NewUserUpdatedHandler(c.userReadRepository)
+	c.userDeletedHandler = projections.NewUserDeletedHandler(c.userReadRepository)
+	c.userErasedHandler = projections.NewUserErasedHandler(c.userReadRepository)
 	c.userNotificationHandler = notifications.NewUserNotificationHandler(c.userReadRepository)
+	c.tenantNotificationHandler = tenant_notifications.NewTenantNotificationHandler()
 }
 
 func (c *Container) initConsumers() error {
 	userProjectionsConsumer := consumers.NewRabbitMQUserProjectionsConsumer(
 		&c.config.RabbitMQ,
 		c.logger,
+		c.inboxRepository,
+		c.txManager,
 		c.userCreatedHandler,
+		c.userUpdatedHandler,
+		c.userDeletedHandler,
+		c.userErasedHandler,
 	)
 
 	userNotificationConsumer := consumers.NewRabbitMQUserNotificationConsumer(
 		&c.config.RabbitMQ,
 		c.logger,
+		c.inboxRepository,
+		c.txManager,
 		c.userNotificationHandler,
 	)
 
+	tenantNotificationConsumer := tenant_consumers.NewRabbitMQTenantNotificationConsumer(
+		&c.config.RabbitMQ,
+		c.logger,
+		c.inboxRepository,
+		c.txManager,
+		c.tenantNotificationHandler,
+	)
+
 	c.eventConsumers = []shared_ports.EventConsumer{
 		userProjectionsConsumer,
 		userNotificationConsumer,
+		tenantNotificationConsumer,
 	}
 
 	return nil
@@ -127,6 +339,14 @@ func (c *Container) GetCreateUserUseCase() *commands.CreateUserUseCase {
 	return c.createUserUseCase
 }
 
+func (c *Container) GetUpdateUserUseCase() *commands.UpdateUserUseCase {
+	return c.updateUserUseCase
+}
+
+func (c *Container) GetDeleteUserUseCase() *commands.DeleteUserUseCase {
+	return c.deleteUserUseCase
+}
+
 func (c *Container) GetUserCreatedHandler() *projections.UserCreatedHandler {
 	return c.userCreatedHandler
 }
@@ -135,14 +355,94 @@ func (c *Container) GetGetUserUseCase() *queries.GetUserUseCase {
 	return c.getUserUseCase
 }
 
+func (c *Container) GetListUsersUseCase() *queries.ListUsersUseCase {
+	return c.listUsersUseCase
+}
+
+func (c *Container) GetLoginUseCase() *auth_commands.LoginUseCase {
+	return c.loginUseCase
+}
+
+func (c *Container) GetRefreshTokenUseCase() *auth_commands.RefreshTokenUseCase {
+	return c.refreshTokenUseCase
+}
+
+func (c *Container) GetLogoutUseCase() *auth_commands.LogoutUseCase {
+	return c.logoutUseCase
+}
+
+func (c *Container) GetCreateTenantUseCase() *tenant_commands.CreateTenantUseCase {
+	return c.createTenantUseCase
+}
+
+func (c *Container) GetUpdateTenantUseCase() *tenant_commands.UpdateTenantUseCase {
+	return c.updateTenantUseCase
+}
+
+func (c *Container) GetChangeTenantStatusUseCase() *tenant_commands.ChangeTenantStatusUseCase {
+	return c.changeTenantStatusUseCase
+}
+
+func (c *Container) GetGetTenantUseCase() *tenant_queries.GetTenantUseCase {
+	return c.getTenantUseCase
+}
+
+func (c *Container) GetListTenantsUseCase() *tenant_queries.ListTenantsUseCase {
+	return c.listTenantsUseCase
+}
+
+func (c *Container) GetTenantLookup() shared_ports.TenantLookup {
+	return c.tenantLookup
+}
+
+func (c *Container) GetCreateFeatureFlagUseCase() *flag_commands.CreateFeatureFlagUseCase {
+	return c.createFeatureFlagUseCase
+}
+
+func (c *Container) GetUpdateFeatureFlagUseCase() *flag_commands.UpdateFeatureFlagUseCase {
+	return c.updateFeatureFlagUseCase
+}
+
+func (c *Container) GetSetFeatureFlagOverrideUseCase() *flag_commands.SetFeatureFlagOverrideUseCase {
+	return c.setFeatureFlagOverrideUseCase
+}
+
+func (c *Container) GetRemoveFeatureFlagOverrideUseCase() *flag_commands.RemoveFeatureFlagOverrideUseCase {
+	return c.removeFeatureFlagOverrideUseCase
+}
+
+func (c *Container) GetGetFeatureFlagUseCase() *flag_queries.GetFeatureFlagUseCase {
+	return c.getFeatureFlagUseCase
+}
+
+func (c *Container) GetListFeatureFlagsUseCase() *flag_queries.ListFeatureFlagsUseCase {
+	return c.listFeatureFlagsUseCase
+}
+
+func (c *Container) GetRateLimiter() *middleware.RateLimiter {
+	return c.rateLimiter
+}
+
+func (c *Container) GetTokenService() shared_ports.TokenService {
+	return c.tokenService
+}
+
 func (c *Container) GetEventConsumers() []shared_ports.EventConsumer {
 	return c.eventConsumers
 }
 
+func (c *Container) GetTransactionManager() shared_ports.TransactionManager {
+	return c.txManager
+}
+
 func (c *Container) GetEventBus() shared_ports.EventBus {
 	return c.eventBus
 }
 
+func (c *Container) GetOutboxRelay() *outbox.Relay {
+	return c.outboxRelay
+}
+
 func (c *Container) GetConfig() *config.Config {
 	return c.config
 }
@@ -150,6 +450,12 @@ func (c *Container) GetConfig() *config.Config {
 func (c *Container) Close() error {
 	var errs []error
 
+	if c.outboxRelay != nil {
+		if err := c.outboxRelay.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop outbox relay: %w", err))
+		}
+	}
+
 	if c.eventBus != nil {
 		c.eventBus.Close()
 	}
@@ -162,6 +468,21 @@ func (c *Container) Close() error {
 		}
 	}
 
+	if c.connections != nil {
+		if err := c.connections.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close tenant connections: %w", err))
+		}
+	}
+
+	if c.controlPlaneDB != nil {
+		sqlDB, err := c.controlPlaneDB.DB()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get control plane database connection: %w", err))
+		} else if err := sqlDB.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close control plane database: %w", err))
+		}
+	}
+
 	if c.db != nil {
 		sqlDB, err := c.db.DB()
 		if err != nil {
@@ -0,0 +1,73 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	shared_persistence "github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+)
+
+// NewMigrators conecta a cada base de datos o esquema de tenant sin comprobar
+// la versión del esquema, para que cmd/migrate pueda corregirla. Con tenancy
+// compartida devuelve un único migrator con clave "".
+func NewMigrators(ctx context.Context) (map[string]*migrations.Migrator, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	migrators, _, err := openMigrators(ctx, cfg)
+	return migrators, err
+}
+
+// openMigrators conecta con el rol de sistema, dueño de las tablas: el rol de
+// la aplicación no puede cambiar el esquema. La función devuelta cierra las
+// conexiones.
+func openMigrators(ctx context.Context, cfg *config.Config) (map[string]*migrations.Migrator, func() error, error) {
+	cfg = cfg.AsSystem()
+
+	db, err := shared_persistence.ConnectDatabase(&cfg.DB)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+
+	connections, err := shared_persistence.NewTenantConnectionResolver(cfg, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeConnections := func() error {
+		errs := []error{connections.Close()}
+		if sqlDB, err := db.DB(); err == nil {
+			errs = append(errs, sqlDB.Close())
+		}
+		return errors.Join(errs...)
+	}
+
+	databases, err := connections.Connections(ctx)
+	if err != nil {
+		closeConnections()
+		return nil, nil, err
+	}
+
+	// La clave "" es la base de datos de control; el resto son tenants
+	migrators := make(map[string]*migrations.Migrator, len(databases))
+	for tenantID, tenantDB := range databases {
+		newMigrator := migrations.NewTenantMigrator
+		if tenantID == "" {
+			newMigrator = migrations.NewMigrator
+		}
+
+		migrator, err := newMigrator(tenantDB)
+		if err != nil {
+			closeConnections()
+			return nil, nil, err
+		}
+		migrators[tenantID] = migrator
+	}
+
+	return migrators, closeConnections, nil
+}
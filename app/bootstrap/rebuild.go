@@ -0,0 +1,91 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/infrastructure/persistence"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/eventstore"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Proyecciones que se pueden regenerar con cmd/rebuild-projection
+const ProjectionUsersRead = "users_read"
+
+// RebuildProjection regenera una proyección desde el write model que indique
+// la configuración. Solo necesita la base de datos, no el broker.
+func RebuildProjection(ctx context.Context, projection, tenantID string, batchSize int) error {
+	if projection != ProjectionUsersRead {
+		return fmt.Errorf("unknown projection %q", projection)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	logger, err := initLogger()
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+	defer logger.Sync()
+
+	// Recorre todos los tenants a la vez: necesita el rol de sistema, que no
+	// está sujeto a row-level security
+	_, connections, err := initDatabase(cfg.AsSystem(), logger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer connections.Close()
+
+	// Con un tenant concreto basta su base de datos; sin él se regenera la
+	// proyección en todas
+	databases := map[string]*gorm.DB{}
+	if tenantID != "" {
+		db, err := connections.Resolve(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		databases[tenantID] = db
+	} else if databases, err = connections.Connections(ctx); err != nil {
+		return err
+	}
+
+	logger.Info("rebuilding projection",
+		zap.String("projection", projection),
+		zap.String("tenant", tenantID),
+		zap.String("source", cfg.Events.UserPersistence),
+		zap.Int("databases", len(databases)),
+	)
+
+	for database, db := range databases {
+		rebuilder := newUserReadRebuilder(cfg, db, batchSize)
+
+		err = rebuilder.Rebuild(ctx, tenantID, func(progress persistence.RebuildProgress) {
+			logger.Info("rebuild progress",
+				zap.String("projection", projection),
+				zap.String("database", database),
+				zap.Int64("processed", progress.Processed),
+				zap.Int64("written", progress.Written),
+				zap.Int64("total", progress.Total),
+			)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rebuild %s: %w", projection, err)
+		}
+	}
+
+	logger.Info("projection rebuilt", zap.String("projection", projection))
+	return nil
+}
+
+func newUserReadRebuilder(cfg *config.Config, db *gorm.DB, batchSize int) *persistence.UserReadRebuilder {
+	if cfg.Events.UserPersistence == config.UserPersistenceEventSourced {
+		repository := persistence.NewEventSourcedUserRepository(db, eventstore.NewGormEventStore(db), cfg.Events.SnapshotEvery)
+		return persistence.NewEventSourcedUserReadRebuilder(db, repository, batchSize)
+	}
+
+	return persistence.NewStateUserReadRebuilder(db, batchSize)
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/ports"
+	user_exceptions "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	user_ports "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type LoginCommand struct {
+	TenantID string
+	Email    string
+	Password string
+}
+
+type LoginUseCase struct {
+	userRepo         user_ports.UserRepository
+	refreshTokenRepo ports.RefreshTokenRepository
+	hasher           shared_ports.Hasher
+	tokenService     shared_ports.TokenService
+	txManager        shared_ports.TransactionManager
+	refreshTokenTTL  time.Duration
+}
+
+func NewLoginUseCase(
+	userRepo user_ports.UserRepository,
+	refreshTokenRepo ports.RefreshTokenRepository,
+	hasher shared_ports.Hasher,
+	tokenService shared_ports.TokenService,
+	txManager shared_ports.TransactionManager,
+	refreshTokenTTL time.Duration,
+) *LoginUseCase {
+	return &LoginUseCase{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		hasher:           hasher,
+		tokenService:     tokenService,
+		txManager:        txManager,
+		refreshTokenTTL:  refreshTokenTTL,
+	}
+}
+
+func (h *LoginUseCase) Execute(ctx context.Context, cmd LoginCommand) (*TokenPair, error) {
+	// Email inexistente y contraseña incorrecta devuelven el mismo error para
+	// no revelar qué cuentas existen
+	email, err := value_objects.NewEmail(cmd.Email)
+	if err != nil {
+		return nil, exceptions.ErrInvalidCredentials
+	}
+
+	var pair *TokenPair
+
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := h.userRepo.FindByEmail(ctx, cmd.TenantID, email)
+		if err != nil {
+			if errors.Is(err, user_exceptions.ErrUserNotFound) {
+				return exceptions.ErrInvalidCredentials
+			}
+			return err
+		}
+
+		if !h.hasher.Verify(user.Password.Hash(), cmd.Password) {
+			return exceptions.ErrInvalidCredentials
+		}
+
+		refreshToken, plain, err := entities.NewRefreshToken(user.TenantID, user.ID, h.refreshTokenTTL)
+		if err != nil {
+			return err
+		}
+
+		if err := h.refreshTokenRepo.Save(ctx, refreshToken); err != nil {
+			return err
+		}
+
+		pair, err = newTokenPair(h.tokenService, user, refreshToken, plain)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pair, nil
+}
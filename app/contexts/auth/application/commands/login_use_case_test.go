@@ -0,0 +1,106 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	user_exceptions "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginUseCaseSuite struct {
+	suite.Suite
+	users   *MockUserRepository
+	tokens  *MockRefreshTokenRepository
+	hasher  *MockHasher
+	service *MockTokenService
+	uc      *commands.LoginUseCase
+	ctx     context.Context
+}
+
+func (s *LoginUseCaseSuite) SetupTest() {
+	s.users = new(MockUserRepository)
+	s.tokens = new(MockRefreshTokenRepository)
+	s.hasher = new(MockHasher)
+	s.service = new(MockTokenService)
+	s.uc = commands.NewLoginUseCase(s.users, s.tokens, s.hasher, s.service, transaction.NewInMemoryTransactionManager(), time.Hour)
+	s.ctx = context.Background()
+}
+
+func TestLoginUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(LoginUseCaseSuite))
+}
+
+func (s *LoginUseCaseSuite) TestExecute_Success() {
+	// Arrange
+	tenantID := "tenant-123"
+	user := existingUser(tenantID)
+
+	s.users.On("FindByEmail", mock.Anything, tenantID, user.Email).Return(user, nil).Once()
+	s.hasher.On("Verify", "hashed", "Secret123").Return(true).Once()
+	s.tokens.On("Save", mock.Anything, mock.MatchedBy(func(t *entities.RefreshToken) bool {
+		return t.UserID == user.ID && t.TenantID == tenantID
+	})).Return(nil).Once()
+	s.service.On("Issue", shared_ports.TokenClaims{Subject: user.ID.String(), TenantID: tenantID, Role: "member"}).Return("access-token", nil).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.LoginCommand{TenantID: tenantID, Email: "John.Doe@example.com", Password: "Secret123"})
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "access-token", pair.AccessToken)
+	assert.NotEmpty(s.T(), pair.RefreshToken)
+	assert.Equal(s.T(), "Bearer", pair.TokenType)
+	assert.Equal(s.T(), 900, pair.ExpiresIn)
+	s.users.AssertExpectations(s.T())
+	s.tokens.AssertExpectations(s.T())
+	s.service.AssertExpectations(s.T())
+}
+
+func (s *LoginUseCaseSuite) TestExecute_WrongPassword() {
+	// Arrange
+	tenantID := "tenant-123"
+	user := existingUser(tenantID)
+
+	s.users.On("FindByEmail", mock.Anything, tenantID, user.Email).Return(user, nil).Once()
+	s.hasher.On("Verify", "hashed", "wrong").Return(false).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.LoginCommand{TenantID: tenantID, Email: "john.doe@example.com", Password: "wrong"})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrInvalidCredentials, err)
+	s.tokens.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (s *LoginUseCaseSuite) TestExecute_UnknownEmail() {
+	// Arrange
+	s.users.On("FindByEmail", mock.Anything, "tenant-123", mock.Anything).Return(nil, user_exceptions.ErrUserNotFound).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.LoginCommand{TenantID: "tenant-123", Email: "ghost@example.com", Password: "Secret123"})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrInvalidCredentials, err)
+	s.hasher.AssertNotCalled(s.T(), "Verify", mock.Anything, mock.Anything)
+}
+
+func (s *LoginUseCaseSuite) TestExecute_MalformedEmail() {
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.LoginCommand{TenantID: "tenant-123", Email: "not-an-email", Password: "Secret123"})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrInvalidCredentials, err)
+	s.users.AssertNotCalled(s.T(), "FindByEmail", mock.Anything, mock.Anything, mock.Anything)
+}
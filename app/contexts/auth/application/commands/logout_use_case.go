@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type LogoutCommand struct {
+	TenantID     string
+	RefreshToken string
+}
+
+type LogoutUseCase struct {
+	refreshTokenRepo ports.RefreshTokenRepository
+	txManager        shared_ports.TransactionManager
+}
+
+func NewLogoutUseCase(refreshTokenRepo ports.RefreshTokenRepository, txManager shared_ports.TransactionManager) *LogoutUseCase {
+	return &LogoutUseCase{refreshTokenRepo: refreshTokenRepo, txManager: txManager}
+}
+
+// Execute revoca la sesión completa del token. Cerrar una sesión inexistente
+// no es un error, así el logout es idempotente.
+func (h *LogoutUseCase) Execute(ctx context.Context, cmd LogoutCommand) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		token, err := h.refreshTokenRepo.FindByHash(ctx, entities.HashRefreshToken(cmd.RefreshToken))
+		if err != nil {
+			if errors.Is(err, exceptions.ErrInvalidRefreshToken) {
+				return nil
+			}
+			return err
+		}
+
+		if token.TenantID != cmd.TenantID {
+			return exceptions.ErrInvalidRefreshToken
+		}
+
+		return h.refreshTokenRepo.RevokeFamily(ctx, token.FamilyID)
+	})
+}
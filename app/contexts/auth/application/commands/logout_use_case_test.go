@@ -0,0 +1,54 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLogoutUseCase_RevokesFamily(t *testing.T) {
+	tokens := new(MockRefreshTokenRepository)
+	uc := commands.NewLogoutUseCase(tokens, transaction.NewInMemoryTransactionManager())
+	current, plain, _ := entities.NewRefreshToken("tenant-123", uuid.New(), time.Hour)
+
+	tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+	tokens.On("RevokeFamily", mock.Anything, current.FamilyID).Return(nil).Once()
+
+	err := uc.Execute(context.Background(), commands.LogoutCommand{TenantID: "tenant-123", RefreshToken: plain})
+
+	assert.NoError(t, err)
+	tokens.AssertExpectations(t)
+}
+
+func TestLogoutUseCase_UnknownTokenIsIdempotent(t *testing.T) {
+	tokens := new(MockRefreshTokenRepository)
+	uc := commands.NewLogoutUseCase(tokens, transaction.NewInMemoryTransactionManager())
+
+	tokens.On("FindByHash", mock.Anything, mock.Anything).Return(nil, exceptions.ErrInvalidRefreshToken).Once()
+
+	err := uc.Execute(context.Background(), commands.LogoutCommand{TenantID: "tenant-123", RefreshToken: "unknown"})
+
+	assert.NoError(t, err)
+	tokens.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+}
+
+func TestLogoutUseCase_TenantMismatch(t *testing.T) {
+	tokens := new(MockRefreshTokenRepository)
+	uc := commands.NewLogoutUseCase(tokens, transaction.NewInMemoryTransactionManager())
+	current, plain, _ := entities.NewRefreshToken("tenant-123", uuid.New(), time.Hour)
+
+	tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+
+	err := uc.Execute(context.Background(), commands.LogoutCommand{TenantID: "tenant-999", RefreshToken: plain})
+
+	assert.Equal(t, exceptions.ErrInvalidRefreshToken, err)
+	tokens.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+}
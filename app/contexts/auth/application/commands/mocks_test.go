@@ -0,0 +1,111 @@
+package commands_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	user_entities "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockUserRepository struct{ mock.Mock }
+
+func (m *MockUserRepository) Save(ctx context.Context, user *user_entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+func (m *MockUserRepository) Update(ctx context.Context, user *user_entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+func (m *MockUserRepository) SoftDelete(ctx context.Context, user *user_entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+func (m *MockUserRepository) Anonymize(ctx context.Context, user *user_entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+func (m *MockUserRepository) FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*user_entities.User, error) {
+	args := m.Called(ctx, tenantID, id)
+	if v := args.Get(0); v != nil {
+		return v.(*user_entities.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *MockUserRepository) FindByIDIncludingDeleted(ctx context.Context, tenantID string, id uuid.UUID) (*user_entities.User, error) {
+	args := m.Called(ctx, tenantID, id)
+	if v := args.Get(0); v != nil {
+		return v.(*user_entities.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *MockUserRepository) FindByEmail(ctx context.Context, tenantID string, email value_objects.Email) (*user_entities.User, error) {
+	args := m.Called(ctx, tenantID, email)
+	if v := args.Get(0); v != nil {
+		return v.(*user_entities.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, tenantID string, email value_objects.Email) (bool, error) {
+	args := m.Called(ctx, tenantID, email)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockRefreshTokenRepository struct{ mock.Mock }
+
+func (m *MockRefreshTokenRepository) Save(ctx context.Context, token *entities.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+func (m *MockRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if v := args.Get(0); v != nil {
+		return v.(*entities.RefreshToken), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *MockRefreshTokenRepository) Revoke(ctx context.Context, token *entities.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	args := m.Called(ctx, familyID)
+	return args.Error(0)
+}
+
+type MockHasher struct{ mock.Mock }
+
+func (m *MockHasher) Hash(plainPassword string) (string, error) {
+	args := m.Called(plainPassword)
+	return args.String(0), args.Error(1)
+}
+func (m *MockHasher) Verify(hashedPassword, plainPassword string) bool {
+	args := m.Called(hashedPassword, plainPassword)
+	return args.Bool(0)
+}
+
+type MockTokenService struct{ mock.Mock }
+
+func (m *MockTokenService) Issue(claims shared_ports.TokenClaims) (string, error) {
+	args := m.Called(claims)
+	return args.String(0), args.Error(1)
+}
+func (m *MockTokenService) Parse(token string) (*shared_ports.TokenClaims, error) {
+	args := m.Called(token)
+	if v := args.Get(0); v != nil {
+		return v.(*shared_ports.TokenClaims), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *MockTokenService) AccessTokenTTL() time.Duration { return 15 * time.Minute }
+
+func existingUser(tenantID string) *user_entities.User {
+	email, _ := value_objects.NewEmail("john.doe@example.com")
+	user, _ := user_entities.NewUser(tenantID, "John Doe", email, value_objects.NewPasswordFromHash("hashed"), nil)
+	return user
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/ports"
+	user_ports "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type RefreshTokenCommand struct {
+	TenantID     string
+	RefreshToken string
+}
+
+type RefreshTokenUseCase struct {
+	userRepo         user_ports.UserRepository
+	refreshTokenRepo ports.RefreshTokenRepository
+	tokenService     shared_ports.TokenService
+	txManager        shared_ports.TransactionManager
+	refreshTokenTTL  time.Duration
+}
+
+func NewRefreshTokenUseCase(
+	userRepo user_ports.UserRepository,
+	refreshTokenRepo ports.RefreshTokenRepository,
+	tokenService shared_ports.TokenService,
+	txManager shared_ports.TransactionManager,
+	refreshTokenTTL time.Duration,
+) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		tokenService:     tokenService,
+		txManager:        txManager,
+		refreshTokenTTL:  refreshTokenTTL,
+	}
+}
+
+func (h *RefreshTokenUseCase) Execute(ctx context.Context, cmd RefreshTokenCommand) (*TokenPair, error) {
+	var pair *TokenPair
+	reused := false
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		current, err := h.refreshTokenRepo.FindByHash(ctx, entities.HashRefreshToken(cmd.RefreshToken))
+		if err != nil {
+			return err
+		}
+
+		if current.TenantID != cmd.TenantID {
+			return exceptions.ErrInvalidRefreshToken
+		}
+
+		// Un token ya rotado que vuelve a usarse indica robo: se revoca la
+		// familia completa. La revocación debe confirmarse, por eso el error se
+		// devuelve fuera de la transacción. Las rutas de auth no corren dentro
+		// de TenantScopeMiddleware: su transacción revertiría la revocación
+		if current.IsRevoked() {
+			reused = true
+			return h.refreshTokenRepo.RevokeFamily(ctx, current.FamilyID)
+		}
+
+		if current.IsExpired() {
+			return exceptions.ErrInvalidRefreshToken
+		}
+
+		// El usuario pudo ser eliminado después del login
+		user, err := h.userRepo.FindByID(ctx, current.TenantID, current.UserID)
+		if err != nil {
+			return exceptions.ErrInvalidRefreshToken
+		}
+
+		next, plain, err := current.Rotate(h.refreshTokenTTL)
+		if err != nil {
+			return err
+		}
+
+		if err := h.refreshTokenRepo.Save(ctx, next); err != nil {
+			return err
+		}
+
+		if err := h.refreshTokenRepo.Revoke(ctx, current); err != nil {
+			return err
+		}
+
+		pair, err = newTokenPair(h.tokenService, user, next, plain)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reused {
+		return nil, exceptions.ErrRefreshTokenReused
+	}
+
+	return pair, nil
+}
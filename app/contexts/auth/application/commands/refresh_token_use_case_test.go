@@ -0,0 +1,153 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	user_exceptions "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshTokenUseCaseSuite struct {
+	suite.Suite
+	users   *MockUserRepository
+	tokens  *MockRefreshTokenRepository
+	service *MockTokenService
+	tx      *transaction.InMemoryTransactionManager
+	uc      *commands.RefreshTokenUseCase
+	ctx     context.Context
+}
+
+func (s *RefreshTokenUseCaseSuite) SetupTest() {
+	s.users = new(MockUserRepository)
+	s.tokens = new(MockRefreshTokenRepository)
+	s.service = new(MockTokenService)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.uc = commands.NewRefreshTokenUseCase(s.users, s.tokens, s.service, s.tx, time.Hour)
+	s.ctx = context.Background()
+}
+
+func TestRefreshTokenUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTokenUseCaseSuite))
+}
+
+func (s *RefreshTokenUseCaseSuite) TestExecute_RotatesToken() {
+	// Arrange
+	tenantID := "tenant-123"
+	user := existingUser(tenantID)
+	current, plain, _ := entities.NewRefreshToken(tenantID, user.ID, time.Hour)
+
+	s.tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+	s.users.On("FindByID", mock.Anything, tenantID, user.ID).Return(user, nil).Once()
+	s.tokens.On("Save", mock.Anything, mock.MatchedBy(func(t *entities.RefreshToken) bool {
+		return t.FamilyID == current.FamilyID && t.ID != current.ID
+	})).Return(nil).Once()
+	s.tokens.On("Revoke", mock.Anything, current).Return(nil).Once()
+	// El rol se toma del usuario actual, no del token anterior
+	user.Role = authorization.RoleTenantAdmin
+	s.service.On("Issue", mock.MatchedBy(func(c shared_ports.TokenClaims) bool {
+		return c.Subject == user.ID.String() && c.Role == string(authorization.RoleTenantAdmin)
+	})).Return("new-access-token", nil).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.RefreshTokenCommand{TenantID: tenantID, RefreshToken: plain})
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "new-access-token", pair.AccessToken)
+	assert.NotEqual(s.T(), plain, pair.RefreshToken)
+	assert.True(s.T(), current.IsRevoked())
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.tokens.AssertExpectations(s.T())
+}
+
+func (s *RefreshTokenUseCaseSuite) TestExecute_ReuseRevokesFamily() {
+	// Arrange
+	tenantID := "tenant-123"
+	current, plain, _ := entities.NewRefreshToken(tenantID, existingUser(tenantID).ID, time.Hour)
+	current.Revoke()
+
+	s.tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+	s.tokens.On("RevokeFamily", mock.Anything, current.FamilyID).Return(nil).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.RefreshTokenCommand{TenantID: tenantID, RefreshToken: plain})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrRefreshTokenReused, err)
+	// La revocación de la familia debe confirmarse aunque la petición falle
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.tokens.AssertExpectations(s.T())
+	s.service.AssertNotCalled(s.T(), "Issue", mock.Anything)
+}
+
+func (s *RefreshTokenUseCaseSuite) TestExecute_ExpiredToken() {
+	// Arrange
+	tenantID := "tenant-123"
+	current, plain, _ := entities.NewRefreshToken(tenantID, existingUser(tenantID).ID, -time.Minute)
+
+	s.tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.RefreshTokenCommand{TenantID: tenantID, RefreshToken: plain})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrInvalidRefreshToken, err)
+	s.tokens.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (s *RefreshTokenUseCaseSuite) TestExecute_TenantMismatch() {
+	// Arrange
+	current, plain, _ := entities.NewRefreshToken("tenant-123", existingUser("tenant-123").ID, time.Hour)
+
+	s.tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.RefreshTokenCommand{TenantID: "tenant-999", RefreshToken: plain})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrInvalidRefreshToken, err)
+}
+
+func (s *RefreshTokenUseCaseSuite) TestExecute_DeletedUser() {
+	// Arrange
+	tenantID := "tenant-123"
+	user := existingUser(tenantID)
+	current, plain, _ := entities.NewRefreshToken(tenantID, user.ID, time.Hour)
+
+	s.tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+	s.users.On("FindByID", mock.Anything, tenantID, user.ID).Return(nil, user_exceptions.ErrUserNotFound).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.RefreshTokenCommand{TenantID: tenantID, RefreshToken: plain})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrInvalidRefreshToken, err)
+	assert.False(s.T(), current.IsRevoked())
+}
+
+func (s *RefreshTokenUseCaseSuite) TestExecute_UnknownToken() {
+	// Arrange
+	s.tokens.On("FindByHash", mock.Anything, entities.HashRefreshToken("unknown")).Return(nil, exceptions.ErrInvalidRefreshToken).Once()
+
+	// Act
+	pair, err := s.uc.Execute(s.ctx, commands.RefreshTokenCommand{TenantID: "tenant-123", RefreshToken: "unknown"})
+
+	// Assert
+	assert.Nil(s.T(), pair)
+	assert.Equal(s.T(), exceptions.ErrInvalidRefreshToken, err)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+}
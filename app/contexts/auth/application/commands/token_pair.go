@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	user_entities "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// newTokenPair emite el access token con el rol vigente del usuario, de modo
+// que un cambio de rol se aplica como tarde en el siguiente refresh.
+func newTokenPair(tokenService shared_ports.TokenService, user *user_entities.User, refreshToken *entities.RefreshToken, plainRefreshToken string) (*TokenPair, error) {
+	accessToken, err := tokenService.Issue(shared_ports.TokenClaims{
+		Subject:  user.ID.String(),
+		TenantID: user.TenantID,
+		Role:     string(user.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: plainRefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(tokenService.AccessTokenTTL().Seconds()),
+	}, nil
+}
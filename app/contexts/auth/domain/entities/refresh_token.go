@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const refreshTokenBytes = 32
+
+// RefreshToken es una sesión rotativa. Solo se persiste el hash del token; cada
+// rotación revoca el token usado y emite otro de la misma familia, de modo que
+// reutilizar un token revocado permite revocar toda la sesión.
+type RefreshToken struct {
+	ID           uuid.UUID
+	TenantID     string
+	UserID       uuid.UUID
+	FamilyID     uuid.UUID
+	TokenHash    string
+	ExpiresAt    time.Time
+	RevokedAt    *time.Time
+	ReplacedByID *uuid.UUID
+	CreatedAt    time.Time
+}
+
+// NewRefreshToken inicia una familia nueva y devuelve el token en claro, que
+// solo se entrega al cliente.
+func NewRefreshToken(tenantID string, userID uuid.UUID, ttl time.Duration) (*RefreshToken, string, error) {
+	return newRefreshToken(tenantID, userID, uuid.New(), ttl)
+}
+
+func newRefreshToken(tenantID string, userID, familyID uuid.UUID, ttl time.Duration) (*RefreshToken, string, error) {
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	plain := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := time.Now()
+	return &RefreshToken{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: HashRefreshToken(plain),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, plain, nil
+}
+
+func HashRefreshToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t *RefreshToken) IsExpired() bool {
+	return !time.Now().Before(t.ExpiresAt)
+}
+
+func (t *RefreshToken) Revoke() {
+	if t.RevokedAt != nil {
+		return
+	}
+	now := time.Now()
+	t.RevokedAt = &now
+}
+
+// Rotate revoca el token actual y devuelve su sucesor en la misma familia.
+func (t *RefreshToken) Rotate(ttl time.Duration) (*RefreshToken, string, error) {
+	next, plain, err := newRefreshToken(t.TenantID, t.UserID, t.FamilyID, ttl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	t.Revoke()
+	t.ReplacedByID = &next.ID
+
+	return next, plain, nil
+}
@@ -0,0 +1,55 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRefreshToken(t *testing.T) {
+	userID := uuid.New()
+
+	token, plain, err := entities.NewRefreshToken("tenant-123", userID, time.Hour)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, plain)
+	assert.Equal(t, entities.HashRefreshToken(plain), token.TokenHash)
+	assert.NotEqual(t, plain, token.TokenHash)
+	assert.Equal(t, userID, token.UserID)
+	assert.NotEqual(t, uuid.Nil, token.FamilyID)
+	assert.False(t, token.IsRevoked())
+	assert.False(t, token.IsExpired())
+}
+
+func TestRefreshToken_Rotate(t *testing.T) {
+	current, plain, _ := entities.NewRefreshToken("tenant-123", uuid.New(), time.Hour)
+
+	next, nextPlain, err := current.Rotate(time.Hour)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, plain, nextPlain)
+	assert.Equal(t, current.FamilyID, next.FamilyID)
+	assert.Equal(t, current.UserID, next.UserID)
+	assert.True(t, current.IsRevoked())
+	assert.Equal(t, next.ID, *current.ReplacedByID)
+	assert.False(t, next.IsRevoked())
+}
+
+func TestRefreshToken_IsExpired(t *testing.T) {
+	token, _, _ := entities.NewRefreshToken("tenant-123", uuid.New(), -time.Second)
+
+	assert.True(t, token.IsExpired())
+}
+
+func TestRefreshToken_RevokeKeepsFirstTimestamp(t *testing.T) {
+	token, _, _ := entities.NewRefreshToken("tenant-123", uuid.New(), time.Hour)
+
+	token.Revoke()
+	first := *token.RevokedAt
+	token.Revoke()
+
+	assert.Equal(t, first, *token.RevokedAt)
+}
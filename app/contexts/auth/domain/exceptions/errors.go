@@ -0,0 +1,9 @@
+package exceptions
+
+import base_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+
+var (
+	ErrInvalidCredentials  = base_exceptions.NewUnauthorizedError("invalid credentials", "")
+	ErrInvalidRefreshToken = base_exceptions.NewUnauthorizedError("invalid refresh token", "")
+	ErrRefreshTokenReused  = base_exceptions.NewUnauthorizedError("refresh token reuse detected", "the session has been revoked")
+)
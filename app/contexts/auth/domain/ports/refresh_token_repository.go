@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRepository interface {
+	Save(ctx context.Context, token *entities.RefreshToken) error
+	// FindByHash bloquea la fila dentro de una transacción para que dos
+	// rotaciones concurrentes del mismo token no puedan tener éxito.
+	FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	Revoke(ctx context.Context, token *entities.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+}
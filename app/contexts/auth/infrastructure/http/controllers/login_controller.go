@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+func LoginController(useCase *commands.LoginUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req LoginRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return shared_exceptions.NewBadRequestError("invalid request body", err.Error())
+		}
+
+		tenantID := c.Locals("tenant_id").(string)
+
+		cmd := commands.LoginCommand{
+			TenantID: tenantID,
+			Email:    req.Email,
+			Password: req.Password,
+		}
+
+		tokens, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(tokens)
+	}
+}
@@ -0,0 +1,170 @@
+package controllers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/infrastructure/http/controllers"
+	user_entities "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	user_ports "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	shared_middleware "github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/security"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/tenancy"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// Mocks
+
+type MockUserRepository struct {
+	mock.Mock
+	user_ports.UserRepository
+}
+
+func (m *MockUserRepository) FindByEmail(ctx context.Context, tenantID string, email value_objects.Email) (*user_entities.User, error) {
+	args := m.Called(ctx, tenantID, email)
+	if v := args.Get(0); v != nil {
+		return v.(*user_entities.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockRefreshTokenRepository struct{ mock.Mock }
+
+func (m *MockRefreshTokenRepository) Save(ctx context.Context, token *entities.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+func (m *MockRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if v := args.Get(0); v != nil {
+		return v.(*entities.RefreshToken), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+func (m *MockRefreshTokenRepository) Revoke(ctx context.Context, token *entities.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	args := m.Called(ctx, familyID)
+	return args.Error(0)
+}
+
+func setupAuthApp(t *testing.T, users *MockUserRepository, tokens *MockRefreshTokenRepository) (*fiber.App, shared_ports.TokenService) {
+	tokenService, err := security.NewJWTTokenService(&config.AuthConfig{
+		JWTAlgorithm:   "HS256",
+		JWTSecret:      "test-secret-with-at-least-32-bytes!",
+		JWTIssuer:      "go-hexagonal",
+		AccessTokenTTL: time.Minute,
+	})
+	require.NoError(t, err)
+
+	hasher := security.NewBcryptHasher()
+	txManager := transaction.NewInMemoryTransactionManager()
+
+	app := fiber.New(fiber.Config{ErrorHandler: shared_middleware.ErrorHandler(zap.NewNop())})
+	app.Use(shared_middleware.TenantMiddleware(tenancy.NewInMemoryTenantLookup(shared_ports.TenantActive)))
+	app.Post("/auth/login", controllers.LoginController(commands.NewLoginUseCase(users, tokens, hasher, tokenService, txManager, time.Hour)))
+	app.Post("/auth/refresh", controllers.RefreshTokenController(commands.NewRefreshTokenUseCase(users, tokens, tokenService, txManager, time.Hour)))
+	app.Post("/auth/logout", controllers.LogoutController(commands.NewLogoutUseCase(tokens, txManager)))
+	return app, tokenService
+}
+
+func registeredUser(t *testing.T, tenantID, password string) *user_entities.User {
+	hasher := security.NewBcryptHasher()
+	hash, err := hasher.Hash(password)
+	require.NoError(t, err)
+
+	email, _ := value_objects.NewEmail("john.doe@example.com")
+	user, _ := user_entities.NewUser(tenantID, "John Doe", email, value_objects.NewPasswordFromHash(hash), nil)
+	return user
+}
+
+func postJSON(app *fiber.App, path, tenantID string, body any) *http.Response {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-Id", tenantID)
+	resp, _ := app.Test(req, -1)
+	return resp
+}
+
+func TestLoginController_IssuesVerifiableTokens(t *testing.T) {
+	users := new(MockUserRepository)
+	tokens := new(MockRefreshTokenRepository)
+	app, tokenService := setupAuthApp(t, users, tokens)
+
+	tenantID := "tenant-123"
+	user := registeredUser(t, tenantID, "Secret123")
+
+	users.On("FindByEmail", mock.Anything, tenantID, user.Email).Return(user, nil).Once()
+	tokens.On("Save", mock.Anything, mock.Anything).Return(nil).Once()
+
+	resp := postJSON(app, "/auth/login", tenantID, map[string]string{"email": "john.doe@example.com", "password": "Secret123"})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var pair commands.TokenPair
+	_ = json.NewDecoder(resp.Body).Decode(&pair)
+
+	claims, err := tokenService.Parse(pair.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID.String(), claims.Subject)
+	assert.Equal(t, tenantID, claims.TenantID)
+	assert.NotEmpty(t, pair.RefreshToken)
+	tokens.AssertExpectations(t)
+}
+
+func TestLoginController_InvalidCredentials(t *testing.T) {
+	users := new(MockUserRepository)
+	tokens := new(MockRefreshTokenRepository)
+	app, _ := setupAuthApp(t, users, tokens)
+
+	tenantID := "tenant-123"
+	user := registeredUser(t, tenantID, "Secret123")
+
+	users.On("FindByEmail", mock.Anything, tenantID, user.Email).Return(user, nil).Once()
+
+	resp := postJSON(app, "/auth/login", tenantID, map[string]string{"email": "john.doe@example.com", "password": "Wrong1234"})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	var payload map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+	assert.Equal(t, "invalid credentials", payload["message"])
+	tokens.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestRefreshTokenController_MissingToken(t *testing.T) {
+	app, _ := setupAuthApp(t, new(MockUserRepository), new(MockRefreshTokenRepository))
+
+	resp := postJSON(app, "/auth/refresh", "tenant-123", map[string]string{})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestLogoutController_RevokesSession(t *testing.T) {
+	tokens := new(MockRefreshTokenRepository)
+	app, _ := setupAuthApp(t, new(MockUserRepository), tokens)
+
+	current, plain, _ := entities.NewRefreshToken("tenant-123", uuid.New(), time.Hour)
+	tokens.On("FindByHash", mock.Anything, current.TokenHash).Return(current, nil).Once()
+	tokens.On("RevokeFamily", mock.Anything, current.FamilyID).Return(nil).Once()
+
+	resp := postJSON(app, "/auth/logout", "tenant-123", map[string]string{"refresh_token": plain})
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	tokens.AssertExpectations(t)
+}
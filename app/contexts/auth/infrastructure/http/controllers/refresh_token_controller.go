@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+func RefreshTokenController(useCase *commands.RefreshTokenUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req RefreshTokenRequest
+
+		if err := c.BodyParser(&req); err != nil || req.RefreshToken == "" {
+			return shared_exceptions.NewBadRequestError("invalid request body", "refresh_token is required")
+		}
+
+		tenantID := c.Locals("tenant_id").(string)
+
+		cmd := commands.RefreshTokenCommand{
+			TenantID:     tenantID,
+			RefreshToken: req.RefreshToken,
+		}
+
+		tokens, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(tokens)
+	}
+}
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/infrastructure/http/controllers"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterAuthRoutes(
+	app fiber.Router,
+	rateLimiter *middleware.RateLimiter,
+	loginUseCase *commands.LoginUseCase,
+	refreshUseCase *commands.RefreshTokenUseCase,
+	logoutUseCase *commands.LogoutUseCase,
+) {
+
+	auth := app.Group("/v1/auth")
+
+	auth.Post("/login",
+		rateLimiter.LimitPerClient(5),
+		controllers.LoginController(loginUseCase),
+	)
+
+	auth.Post("/refresh",
+		rateLimiter.LimitPerClient(5),
+		controllers.RefreshTokenController(refreshUseCase),
+	)
+
+	auth.Post("/logout",
+		rateLimiter.LimitPerClient(5),
+		controllers.LogoutController(logoutUseCase),
+	)
+}
@@ -0,0 +1,102 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/auth/domain/exceptions"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormRefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewGormRefreshTokenRepository(db *gorm.DB) *GormRefreshTokenRepository {
+	return &GormRefreshTokenRepository{db: db}
+}
+
+func (r *GormRefreshTokenRepository) Save(ctx context.Context, token *entities.RefreshToken) error {
+	model := &RefreshTokenModel{
+		ID:           token.ID,
+		TenantID:     token.TenantID,
+		UserID:       token.UserID,
+		FamilyID:     token.FamilyID,
+		TokenHash:    token.TokenHash,
+		ExpiresAt:    token.ExpiresAt,
+		RevokedAt:    token.RevokedAt,
+		ReplacedByID: token.ReplacedByID,
+		CreatedAt:    token.CreatedAt,
+	}
+
+	if err := transaction.DB(ctx, r.db).Create(model).Error; err != nil {
+		return shared_exceptions.NewInternalServerError("failed to save refresh token", err.Error())
+	}
+
+	return nil
+}
+
+func (r *GormRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	var model RefreshTokenModel
+
+	// FOR UPDATE solo bloquea dentro de una transacción; fuera de ella no tiene efecto
+	err := transaction.DB(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ?", tokenHash).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.ErrInvalidRefreshToken
+		}
+		return nil, shared_exceptions.NewInternalServerError("failed to find refresh token", err.Error())
+	}
+
+	return r.toDomain(&model), nil
+}
+
+func (r *GormRefreshTokenRepository) Revoke(ctx context.Context, token *entities.RefreshToken) error {
+	err := transaction.DB(ctx, r.db).
+		Model(&RefreshTokenModel{}).
+		Where("id = ?", token.ID).
+		Updates(map[string]any{
+			"revoked_at":     token.RevokedAt,
+			"replaced_by_id": token.ReplacedByID,
+		}).Error
+	if err != nil {
+		return shared_exceptions.NewInternalServerError("failed to revoke refresh token", err.Error())
+	}
+
+	return nil
+}
+
+func (r *GormRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	err := transaction.DB(ctx, r.db).
+		Model(&RefreshTokenModel{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return shared_exceptions.NewInternalServerError("failed to revoke refresh token family", err.Error())
+	}
+
+	return nil
+}
+
+func (r *GormRefreshTokenRepository) toDomain(model *RefreshTokenModel) *entities.RefreshToken {
+	return &entities.RefreshToken{
+		ID:           model.ID,
+		TenantID:     model.TenantID,
+		UserID:       model.UserID,
+		FamilyID:     model.FamilyID,
+		TokenHash:    model.TokenHash,
+		ExpiresAt:    model.ExpiresAt,
+		RevokedAt:    model.RevokedAt,
+		ReplacedByID: model.ReplacedByID,
+		CreatedAt:    model.CreatedAt,
+	}
+}
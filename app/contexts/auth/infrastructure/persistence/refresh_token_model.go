@@ -0,0 +1,23 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RefreshTokenModel struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantID     string    `gorm:"type:varchar(100);not null;index:idx_refresh_tokens_tenant_user,priority:1"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;index:idx_refresh_tokens_tenant_user,priority:2"`
+	FamilyID     uuid.UUID `gorm:"type:uuid;not null;index:idx_refresh_tokens_family"`
+	TokenHash    string    `gorm:"type:char(64);not null;uniqueIndex:idx_refresh_tokens_hash"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	RevokedAt    *time.Time
+	ReplacedByID *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime"`
+}
+
+func (RefreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// CreateFeatureFlagCommand crea el flag para todos los tenants si Rollout es
+// nil; 0 lo deja activo solo para los tenants con override.
+type CreateFeatureFlagCommand struct {
+	Key         string
+	Description string
+	Enabled     bool
+	Rollout     *int
+}
+
+type CreateFeatureFlagUseCase struct {
+	flagRepo  ports.FeatureFlagRepository
+	txManager shared_ports.TransactionManager
+}
+
+func NewCreateFeatureFlagUseCase(
+	flagRepo ports.FeatureFlagRepository,
+	txManager shared_ports.TransactionManager,
+) *CreateFeatureFlagUseCase {
+	return &CreateFeatureFlagUseCase{
+		flagRepo:  flagRepo,
+		txManager: txManager,
+	}
+}
+
+func (h *CreateFeatureFlagUseCase) Execute(ctx context.Context, cmd CreateFeatureFlagCommand) (*entities.FeatureFlag, error) {
+	rollout := 100
+	if cmd.Rollout != nil {
+		rollout = *cmd.Rollout
+	}
+
+	flag, err := entities.NewFeatureFlag(cmd.Key, cmd.Description, cmd.Enabled, rollout)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return h.flagRepo.Save(ctx, flag)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
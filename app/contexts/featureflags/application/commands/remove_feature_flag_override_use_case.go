@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type RemoveFeatureFlagOverrideCommand struct {
+	Key      string
+	TenantID string
+}
+
+// RemoveFeatureFlagOverrideUseCase devuelve al tenant a la evaluación general
+// del flag.
+type RemoveFeatureFlagOverrideUseCase struct {
+	flagRepo  ports.FeatureFlagRepository
+	txManager shared_ports.TransactionManager
+}
+
+func NewRemoveFeatureFlagOverrideUseCase(
+	flagRepo ports.FeatureFlagRepository,
+	txManager shared_ports.TransactionManager,
+) *RemoveFeatureFlagOverrideUseCase {
+	return &RemoveFeatureFlagOverrideUseCase{
+		flagRepo:  flagRepo,
+		txManager: txManager,
+	}
+}
+
+func (h *RemoveFeatureFlagOverrideUseCase) Execute(ctx context.Context, cmd RemoveFeatureFlagOverrideCommand) (*entities.FeatureFlag, error) {
+	var flag *entities.FeatureFlag
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		flag, err = h.flagRepo.FindByKey(ctx, cmd.Key)
+		if err != nil {
+			return err
+		}
+
+		if err := flag.RemoveOverride(cmd.TenantID); err != nil {
+			return err
+		}
+
+		return h.flagRepo.Update(ctx, flag)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type SetFeatureFlagOverrideCommand struct {
+	Key      string
+	TenantID string
+	Enabled  bool
+}
+
+// SetFeatureFlagOverrideUseCase fuerza el valor del flag para un tenant, por
+// encima de Enabled y del rollout.
+type SetFeatureFlagOverrideUseCase struct {
+	flagRepo  ports.FeatureFlagRepository
+	txManager shared_ports.TransactionManager
+}
+
+func NewSetFeatureFlagOverrideUseCase(
+	flagRepo ports.FeatureFlagRepository,
+	txManager shared_ports.TransactionManager,
+) *SetFeatureFlagOverrideUseCase {
+	return &SetFeatureFlagOverrideUseCase{
+		flagRepo:  flagRepo,
+		txManager: txManager,
+	}
+}
+
+func (h *SetFeatureFlagOverrideUseCase) Execute(ctx context.Context, cmd SetFeatureFlagOverrideCommand) (*entities.FeatureFlag, error) {
+	var flag *entities.FeatureFlag
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		flag, err = h.flagRepo.FindByKey(ctx, cmd.Key)
+		if err != nil {
+			return err
+		}
+
+		if err := flag.SetOverride(cmd.TenantID, cmd.Enabled); err != nil {
+			return err
+		}
+
+		return h.flagRepo.Update(ctx, flag)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
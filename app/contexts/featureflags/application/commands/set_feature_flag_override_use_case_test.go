@@ -0,0 +1,97 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockFeatureFlagRepository struct{ mock.Mock }
+
+func (m *MockFeatureFlagRepository) Save(ctx context.Context, flag *entities.FeatureFlag) error {
+	args := m.Called(ctx, flag)
+	return args.Error(0)
+}
+
+func (m *MockFeatureFlagRepository) Update(ctx context.Context, flag *entities.FeatureFlag) error {
+	args := m.Called(ctx, flag)
+	return args.Error(0)
+}
+
+func (m *MockFeatureFlagRepository) FindByKey(ctx context.Context, key string) (*entities.FeatureFlag, error) {
+	args := m.Called(ctx, key)
+	if f, ok := args.Get(0).(*entities.FeatureFlag); ok {
+		return f, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockFeatureFlagRepository) List(ctx context.Context) ([]*entities.FeatureFlag, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*entities.FeatureFlag), args.Error(1)
+}
+
+type FeatureFlagOverrideUseCaseSuite struct {
+	suite.Suite
+	repo   *MockFeatureFlagRepository
+	tx     *transaction.InMemoryTransactionManager
+	set    *commands.SetFeatureFlagOverrideUseCase
+	remove *commands.RemoveFeatureFlagOverrideUseCase
+	ctx    context.Context
+	flag   *entities.FeatureFlag
+}
+
+func (s *FeatureFlagOverrideUseCaseSuite) SetupTest() {
+	s.repo = new(MockFeatureFlagRepository)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.set = commands.NewSetFeatureFlagOverrideUseCase(s.repo, s.tx)
+	s.remove = commands.NewRemoveFeatureFlagOverrideUseCase(s.repo, s.tx)
+	s.ctx = context.Background()
+
+	flag, err := entities.NewFeatureFlag("display_name", "", true, 0)
+	s.Require().NoError(err)
+	s.flag = flag
+}
+
+func TestFeatureFlagOverrideUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(FeatureFlagOverrideUseCaseSuite))
+}
+
+func (s *FeatureFlagOverrideUseCaseSuite) TestSet_EnablesForTenant() {
+	s.repo.On("FindByKey", mock.Anything, "display_name").Return(s.flag, nil).Once()
+	s.repo.On("Update", mock.Anything, s.flag).Return(nil).Once()
+
+	flag, err := s.set.Execute(s.ctx, commands.SetFeatureFlagOverrideCommand{Key: "display_name", TenantID: "acme", Enabled: true})
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), flag.IsEnabledFor("acme"))
+	assert.False(s.T(), flag.IsEnabledFor("globex"))
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *FeatureFlagOverrideUseCaseSuite) TestSet_UnknownFlag() {
+	s.repo.On("FindByKey", mock.Anything, "beta_ui").Return(nil, exceptions.ErrFlagNotFound).Once()
+
+	_, err := s.set.Execute(s.ctx, commands.SetFeatureFlagOverrideCommand{Key: "beta_ui", TenantID: "acme", Enabled: true})
+
+	assert.Equal(s.T(), exceptions.ErrFlagNotFound, err)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *FeatureFlagOverrideUseCaseSuite) TestRemove_MissingOverride() {
+	s.repo.On("FindByKey", mock.Anything, "display_name").Return(s.flag, nil).Once()
+
+	_, err := s.remove.Execute(s.ctx, commands.RemoveFeatureFlagOverrideCommand{Key: "display_name", TenantID: "acme"})
+
+	assert.Equal(s.T(), exceptions.ErrOverrideNotFound, err)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
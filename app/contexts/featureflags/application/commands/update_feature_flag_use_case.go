@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// UpdateFeatureFlagCommand usa punteros para distinguir "no modificar" (nil)
+// de un valor nuevo.
+type UpdateFeatureFlagCommand struct {
+	Key         string
+	Description *string
+	Enabled     *bool
+	Rollout     *int
+}
+
+type UpdateFeatureFlagUseCase struct {
+	flagRepo  ports.FeatureFlagRepository
+	txManager shared_ports.TransactionManager
+}
+
+func NewUpdateFeatureFlagUseCase(
+	flagRepo ports.FeatureFlagRepository,
+	txManager shared_ports.TransactionManager,
+) *UpdateFeatureFlagUseCase {
+	return &UpdateFeatureFlagUseCase{
+		flagRepo:  flagRepo,
+		txManager: txManager,
+	}
+}
+
+func (h *UpdateFeatureFlagUseCase) Execute(ctx context.Context, cmd UpdateFeatureFlagCommand) (*entities.FeatureFlag, error) {
+	if cmd.Description == nil && cmd.Enabled == nil && cmd.Rollout == nil {
+		return nil, exceptions.ErrEmptyUpdate
+	}
+
+	var flag *entities.FeatureFlag
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		flag, err = h.flagRepo.FindByKey(ctx, cmd.Key)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Description != nil {
+			flag.Describe(*cmd.Description)
+		}
+		if cmd.Enabled != nil {
+			flag.SetEnabled(*cmd.Enabled)
+		}
+		if cmd.Rollout != nil {
+			if err := flag.SetRollout(*cmd.Rollout); err != nil {
+				return err
+			}
+		}
+
+		return h.flagRepo.Update(ctx, flag)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
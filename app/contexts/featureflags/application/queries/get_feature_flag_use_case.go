@@ -0,0 +1,24 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+)
+
+type GetFeatureFlagQuery struct {
+	Key string
+}
+
+type GetFeatureFlagUseCase struct {
+	flagRepo ports.FeatureFlagRepository
+}
+
+func NewGetFeatureFlagUseCase(flagRepo ports.FeatureFlagRepository) *GetFeatureFlagUseCase {
+	return &GetFeatureFlagUseCase{flagRepo: flagRepo}
+}
+
+func (h *GetFeatureFlagUseCase) Execute(ctx context.Context, query GetFeatureFlagQuery) (*entities.FeatureFlag, error) {
+	return h.flagRepo.FindByKey(ctx, query.Key)
+}
@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+)
+
+type ListFeatureFlagsResponse struct {
+	Items []*entities.FeatureFlag `json:"items"`
+}
+
+type ListFeatureFlagsUseCase struct {
+	flagRepo ports.FeatureFlagRepository
+}
+
+func NewListFeatureFlagsUseCase(flagRepo ports.FeatureFlagRepository) *ListFeatureFlagsUseCase {
+	return &ListFeatureFlagsUseCase{flagRepo: flagRepo}
+}
+
+func (h *ListFeatureFlagsUseCase) Execute(ctx context.Context) (*ListFeatureFlagsResponse, error) {
+	flags, err := h.flagRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListFeatureFlagsResponse{Items: flags}, nil
+}
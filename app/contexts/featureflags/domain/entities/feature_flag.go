@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/exceptions"
+)
+
+// La clave se usa desde el código (commands.FeatureDisplayName, etc.), así que
+// se limita a un identificador estable.
+var flagKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_.]{1,99}$`)
+
+// FeatureFlag decide si una funcionalidad está disponible para un tenant. Un
+// override por tenant gana siempre; sin override, el flag tiene que estar
+// activo y el tenant caer dentro del porcentaje de Rollout.
+type FeatureFlag struct {
+	Key         string          `json:"key"`
+	Description string          `json:"description"`
+	Enabled     bool            `json:"enabled"`
+	Rollout     int             `json:"rollout"`
+	Overrides   map[string]bool `json:"overrides"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+func NewFeatureFlag(key, description string, enabled bool, rollout int) (*FeatureFlag, error) {
+	if !flagKeyPattern.MatchString(key) {
+		return nil, exceptions.ErrInvalidFlagKey
+	}
+	if rollout < 0 || rollout > 100 {
+		return nil, exceptions.ErrInvalidRollout
+	}
+
+	now := time.Now()
+	return &FeatureFlag{
+		Key:         key,
+		Description: strings.TrimSpace(description),
+		Enabled:     enabled,
+		Rollout:     rollout,
+		Overrides:   map[string]bool{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (f *FeatureFlag) IsEnabledFor(tenantID string) bool {
+	if enabled, ok := f.Overrides[tenantID]; ok {
+		return enabled
+	}
+	if !f.Enabled {
+		return false
+	}
+	return Bucket(f.Key, tenantID) < f.Rollout
+}
+
+// Bucket asigna a cada tenant un valor estable en [0, 100) por flag: subir el
+// rollout solo añade tenants y cada flag reparte los tenants de forma distinta.
+func Bucket(key, tenantID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(key + ":" + tenantID))
+	return int(h.Sum32() % 100)
+}
+
+func (f *FeatureFlag) SetEnabled(enabled bool) {
+	f.Enabled = enabled
+	f.touch()
+}
+
+func (f *FeatureFlag) SetRollout(rollout int) error {
+	if rollout < 0 || rollout > 100 {
+		return exceptions.ErrInvalidRollout
+	}
+
+	f.Rollout = rollout
+	f.touch()
+	return nil
+}
+
+func (f *FeatureFlag) Describe(description string) {
+	f.Description = strings.TrimSpace(description)
+	f.touch()
+}
+
+func (f *FeatureFlag) SetOverride(tenantID string, enabled bool) error {
+	if strings.TrimSpace(tenantID) == "" {
+		return exceptions.ErrInvalidTenantID
+	}
+
+	if f.Overrides == nil {
+		f.Overrides = map[string]bool{}
+	}
+	f.Overrides[tenantID] = enabled
+	f.touch()
+	return nil
+}
+
+func (f *FeatureFlag) RemoveOverride(tenantID string) error {
+	if _, ok := f.Overrides[tenantID]; !ok {
+		return exceptions.ErrOverrideNotFound
+	}
+
+	delete(f.Overrides, tenantID)
+	f.touch()
+	return nil
+}
+
+func (f *FeatureFlag) touch() {
+	f.UpdatedAt = time.Now()
+}
@@ -0,0 +1,72 @@
+package entities_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/exceptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFlag(t *testing.T, enabled bool, rollout int) *entities.FeatureFlag {
+	flag, err := entities.NewFeatureFlag("display_name", "Campo display_name", enabled, rollout)
+	require.NoError(t, err)
+	return flag
+}
+
+func TestNewFeatureFlag_Validation(t *testing.T) {
+	_, err := entities.NewFeatureFlag("Display Name", "", true, 100)
+	assert.Equal(t, exceptions.ErrInvalidFlagKey, err)
+
+	_, err = entities.NewFeatureFlag("display_name", "", true, 101)
+	assert.Equal(t, exceptions.ErrInvalidRollout, err)
+
+	_, err = entities.NewFeatureFlag("display_name", "", true, -1)
+	assert.Equal(t, exceptions.ErrInvalidRollout, err)
+}
+
+func TestFeatureFlag_IsEnabledFor(t *testing.T) {
+	assert.True(t, newFlag(t, true, 100).IsEnabledFor("acme"))
+	assert.False(t, newFlag(t, true, 0).IsEnabledFor("acme"))
+	assert.False(t, newFlag(t, false, 100).IsEnabledFor("acme"))
+}
+
+func TestFeatureFlag_OverrideWins(t *testing.T) {
+	flag := newFlag(t, false, 0)
+	require.NoError(t, flag.SetOverride("acme", true))
+	assert.True(t, flag.IsEnabledFor("acme"))
+	assert.False(t, flag.IsEnabledFor("globex"))
+
+	flag = newFlag(t, true, 100)
+	require.NoError(t, flag.SetOverride("tenant-50", false))
+	assert.False(t, flag.IsEnabledFor("tenant-50"))
+
+	require.NoError(t, flag.RemoveOverride("tenant-50"))
+	assert.True(t, flag.IsEnabledFor("tenant-50"))
+	assert.Equal(t, exceptions.ErrOverrideNotFound, flag.RemoveOverride("tenant-50"))
+}
+
+func TestFeatureFlag_RolloutIsStableAndMonotonic(t *testing.T) {
+	tenants := make([]string, 1000)
+	for i := range tenants {
+		tenants[i] = fmt.Sprintf("tenant-%d", i)
+	}
+
+	flag := newFlag(t, true, 25)
+	enabledAt25 := map[string]bool{}
+	for _, tenantID := range tenants {
+		if flag.IsEnabledFor(tenantID) {
+			enabledAt25[tenantID] = true
+		}
+	}
+	// Reparto aproximado: ~25% de 1000
+	assert.InDelta(t, 250, len(enabledAt25), 60)
+
+	// Subir el rollout no saca a ningún tenant que ya lo tuviera
+	require.NoError(t, flag.SetRollout(50))
+	for tenantID := range enabledAt25 {
+		assert.True(t, flag.IsEnabledFor(tenantID), tenantID)
+	}
+}
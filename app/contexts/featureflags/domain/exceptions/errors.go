@@ -0,0 +1,13 @@
+package exceptions
+
+import base_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+
+var (
+	ErrFlagNotFound     = base_exceptions.NewNotFoundError("feature flag not found", "")
+	ErrDuplicateFlag    = base_exceptions.NewConflictError("feature flag already exists", "")
+	ErrInvalidFlagKey   = base_exceptions.NewBadRequestError("invalid feature flag key", "key must be 2-100 lowercase letters, digits, '_' or '.' and start with a letter")
+	ErrInvalidRollout   = base_exceptions.NewBadRequestError("invalid rollout percentage", "rollout must be between 0 and 100")
+	ErrInvalidTenantID  = base_exceptions.NewBadRequestError("tenant id cannot be empty", "")
+	ErrOverrideNotFound = base_exceptions.NewNotFoundError("feature flag override not found", "")
+	ErrEmptyUpdate      = base_exceptions.NewBadRequestError("no fields to update", "")
+)
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+)
+
+// FeatureFlagRepository guarda cada flag junto con sus overrides por tenant.
+// Update reemplaza los overrides por los del flag recibido.
+type FeatureFlagRepository interface {
+	Save(ctx context.Context, flag *entities.FeatureFlag) error
+	Update(ctx context.Context, flag *entities.FeatureFlag) error
+	FindByKey(ctx context.Context, key string) (*entities.FeatureFlag, error)
+	List(ctx context.Context) ([]*entities.FeatureFlag, error)
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+)
+
+type entry struct {
+	flag      *entities.FeatureFlag
+	expiresAt time.Time
+}
+
+// CachedFeatureFlags implementa shared_ports.FeatureFlags sobre el
+// repositorio de flags con una caché en memoria por proceso. Un cambio tarda
+// como mucho ttl en verse en cada réplica.
+type CachedFeatureFlags struct {
+	flagRepo ports.FeatureFlagRepository
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+func NewCachedFeatureFlags(flagRepo ports.FeatureFlagRepository, ttl time.Duration) *CachedFeatureFlags {
+	return &CachedFeatureFlags{
+		flagRepo: flagRepo,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[string]entry),
+	}
+}
+
+func (f *CachedFeatureFlags) IsEnabled(ctx context.Context, key, tenantID string) (bool, error) {
+	flag, err := f.load(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if flag == nil {
+		return false, nil
+	}
+	return flag.IsEnabledFor(tenantID), nil
+}
+
+// load devuelve nil si el flag no existe. Las claves salen del código, así
+// que la caché no necesita límite de tamaño.
+func (f *CachedFeatureFlags) load(ctx context.Context, key string) (*entities.FeatureFlag, error) {
+	now := f.now()
+
+	f.mu.Lock()
+	cached, ok := f.entries[key]
+	f.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.flag, nil
+	}
+
+	// Los flags están en la base de datos principal: la lectura no puede ir
+	// por la transacción del tenant, que en modos aislados es otra base de datos
+	flag, err := f.flagRepo.FindByKey(transaction.Detach(ctx), key)
+	if err == exceptions.ErrFlagNotFound {
+		flag, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	f.entries[key] = entry{flag: flag, expiresAt: now.Add(f.ttl)}
+	f.mu.Unlock()
+
+	return flag, nil
+}
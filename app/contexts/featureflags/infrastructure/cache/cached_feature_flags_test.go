@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/exceptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFlagRepository struct {
+	flags map[string]*entities.FeatureFlag
+	err   error
+	calls int
+}
+
+func (r *fakeFlagRepository) Save(ctx context.Context, flag *entities.FeatureFlag) error { return nil }
+func (r *fakeFlagRepository) Update(ctx context.Context, flag *entities.FeatureFlag) error {
+	return nil
+}
+
+func (r *fakeFlagRepository) FindByKey(ctx context.Context, key string) (*entities.FeatureFlag, error) {
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	if flag, ok := r.flags[key]; ok {
+		return flag, nil
+	}
+	return nil, exceptions.ErrFlagNotFound
+}
+
+func (r *fakeFlagRepository) List(ctx context.Context) ([]*entities.FeatureFlag, error) {
+	return nil, nil
+}
+
+func newFeatureFlags(t *testing.T) (*CachedFeatureFlags, *fakeFlagRepository, *time.Time) {
+	flag, err := entities.NewFeatureFlag("display_name", "", true, 100)
+	require.NoError(t, err)
+	require.NoError(t, flag.SetOverride("tenant-50", false))
+
+	repo := &fakeFlagRepository{flags: map[string]*entities.FeatureFlag{"display_name": flag}}
+	flags := NewCachedFeatureFlags(repo, 30*time.Second)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	flags.now = func() time.Time { return now }
+
+	return flags, repo, &now
+}
+
+func TestCachedFeatureFlags_EvaluatesPerTenant(t *testing.T) {
+	flags, repo, _ := newFeatureFlags(t)
+	ctx := context.Background()
+
+	enabled, err := flags.IsEnabled(ctx, "display_name", "acme")
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = flags.IsEnabled(ctx, "display_name", "tenant-50")
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	// Una sola lectura por flag mientras no caduque
+	assert.Equal(t, 1, repo.calls)
+}
+
+func TestCachedFeatureFlags_RefreshesAfterTTL(t *testing.T) {
+	flags, repo, now := newFeatureFlags(t)
+	ctx := context.Background()
+
+	_, _ = flags.IsEnabled(ctx, "display_name", "acme")
+	disabled, err := entities.NewFeatureFlag("display_name", "", false, 100)
+	require.NoError(t, err)
+	repo.flags["display_name"] = disabled
+
+	enabled, _ := flags.IsEnabled(ctx, "display_name", "acme")
+	assert.True(t, enabled)
+
+	*now = now.Add(31 * time.Second)
+	enabled, _ = flags.IsEnabled(ctx, "display_name", "acme")
+	assert.False(t, enabled)
+	assert.Equal(t, 2, repo.calls)
+}
+
+func TestCachedFeatureFlags_UnknownFlagIsDisabled(t *testing.T) {
+	flags, repo, _ := newFeatureFlags(t)
+
+	for range 2 {
+		enabled, err := flags.IsEnabled(context.Background(), "beta_ui", "acme")
+		require.NoError(t, err)
+		assert.False(t, enabled)
+	}
+
+	assert.Equal(t, 1, repo.calls)
+}
+
+func TestCachedFeatureFlags_DoesNotCacheErrors(t *testing.T) {
+	flags, repo, _ := newFeatureFlags(t)
+	repo.err = errors.New("connection refused")
+
+	_, err := flags.IsEnabled(context.Background(), "display_name", "acme")
+	assert.Error(t, err)
+
+	repo.err = nil
+	enabled, err := flags.IsEnabled(context.Background(), "display_name", "acme")
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
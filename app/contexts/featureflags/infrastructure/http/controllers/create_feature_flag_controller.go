@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/commands"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreateFeatureFlagRequest struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	Rollout     *int   `json:"rollout,omitempty"`
+}
+
+func CreateFeatureFlagController(useCase *commands.CreateFeatureFlagUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req CreateFeatureFlagRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return shared_exceptions.NewBadRequestError("invalid request body", err.Error())
+		}
+
+		cmd := commands.CreateFeatureFlagCommand{
+			Key:         req.Key,
+			Description: req.Description,
+			Enabled:     req.Enabled,
+			Rollout:     req.Rollout,
+		}
+
+		flag, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(flag)
+	}
+}
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/commands"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SetFeatureFlagOverrideRequest struct {
+	Enabled *bool `json:"enabled"`
+}
+
+func SetFeatureFlagOverrideController(useCase *commands.SetFeatureFlagOverrideUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req SetFeatureFlagOverrideRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return shared_exceptions.NewBadRequestError("invalid request body", err.Error())
+		}
+		if req.Enabled == nil {
+			return shared_exceptions.NewBadRequestError("enabled is required", "")
+		}
+
+		cmd := commands.SetFeatureFlagOverrideCommand{
+			Key:      c.Params("key"),
+			TenantID: c.Params("tenant_id"),
+			Enabled:  *req.Enabled,
+		}
+
+		flag, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(flag)
+	}
+}
+
+func RemoveFeatureFlagOverrideController(useCase *commands.RemoveFeatureFlagOverrideUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cmd := commands.RemoveFeatureFlagOverrideCommand{
+			Key:      c.Params("key"),
+			TenantID: c.Params("tenant_id"),
+		}
+
+		flag, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(flag)
+	}
+}
@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/queries"
+	"github.com/gofiber/fiber/v2"
+)
+
+func GetFeatureFlagController(useCase *queries.GetFeatureFlagUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		flag, err := useCase.Execute(c.UserContext(), queries.GetFeatureFlagQuery{Key: c.Params("key")})
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(flag)
+	}
+}
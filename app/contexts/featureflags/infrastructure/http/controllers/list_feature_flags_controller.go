@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/queries"
+	"github.com/gofiber/fiber/v2"
+)
+
+func ListFeatureFlagsController(useCase *queries.ListFeatureFlagsUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		response, err := useCase.Execute(c.UserContext())
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(response)
+	}
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/commands"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UpdateFeatureFlagRequest struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	Rollout     *int    `json:"rollout,omitempty"`
+}
+
+func UpdateFeatureFlagController(useCase *commands.UpdateFeatureFlagUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req UpdateFeatureFlagRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return shared_exceptions.NewBadRequestError("invalid request body", err.Error())
+		}
+
+		cmd := commands.UpdateFeatureFlagCommand{
+			Key:         c.Params("key"),
+			Description: req.Description,
+			Enabled:     req.Enabled,
+			Rollout:     req.Rollout,
+		}
+
+		flag, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(flag)
+	}
+}
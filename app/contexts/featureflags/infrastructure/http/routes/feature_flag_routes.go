@@ -0,0 +1,63 @@
+package routes
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/queries"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/infrastructure/http/controllers"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterFeatureFlagRoutes registra la administración de feature flags. Como
+// la de tenants, opera sobre la base de datos principal y debe registrarse
+// antes de TenantScopeMiddleware.
+func RegisterFeatureFlagRoutes(
+	app fiber.Router,
+	rateLimiter *middleware.RateLimiter,
+	createUseCase *commands.CreateFeatureFlagUseCase,
+	getUseCase *queries.GetFeatureFlagUseCase,
+	listUseCase *queries.ListFeatureFlagsUseCase,
+	updateUseCase *commands.UpdateFeatureFlagUseCase,
+	setOverrideUseCase *commands.SetFeatureFlagOverrideUseCase,
+	removeOverrideUseCase *commands.RemoveFeatureFlagOverrideUseCase,
+) {
+
+	flags := app.Group("/v1/feature-flags")
+
+	flags.Post("/",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionFeatureFlagsManage),
+		controllers.CreateFeatureFlagController(createUseCase),
+	)
+
+	flags.Get("/",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionFeatureFlagsRead),
+		controllers.ListFeatureFlagsController(listUseCase),
+	)
+
+	flags.Get("/:key",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionFeatureFlagsRead),
+		controllers.GetFeatureFlagController(getUseCase),
+	)
+
+	flags.Patch("/:key",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionFeatureFlagsManage),
+		controllers.UpdateFeatureFlagController(updateUseCase),
+	)
+
+	flags.Put("/:key/overrides/:tenant_id",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionFeatureFlagsManage),
+		controllers.SetFeatureFlagOverrideController(setOverrideUseCase),
+	)
+
+	flags.Delete("/:key/overrides/:tenant_id",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionFeatureFlagsManage),
+		controllers.RemoveFeatureFlagOverrideController(removeOverrideUseCase),
+	)
+}
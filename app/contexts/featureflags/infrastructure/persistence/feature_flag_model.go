@@ -0,0 +1,26 @@
+package persistence
+
+import "time"
+
+type FeatureFlagModel struct {
+	Key         string    `gorm:"type:varchar(100);primaryKey"`
+	Description string    `gorm:"type:text;not null"`
+	Enabled     bool      `gorm:"not null"`
+	Rollout     int       `gorm:"type:smallint;not null"`
+	CreatedAt   time.Time `gorm:"type:timestamptz;not null"`
+	UpdatedAt   time.Time `gorm:"type:timestamptz;not null"`
+}
+
+func (FeatureFlagModel) TableName() string {
+	return "feature_flags"
+}
+
+type FeatureFlagOverrideModel struct {
+	FlagKey  string `gorm:"type:varchar(100);primaryKey"`
+	TenantID string `gorm:"type:varchar(100);primaryKey"`
+	Enabled  bool   `gorm:"not null"`
+}
+
+func (FeatureFlagOverrideModel) TableName() string {
+	return "feature_flag_overrides"
+}
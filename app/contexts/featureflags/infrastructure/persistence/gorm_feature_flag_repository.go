@@ -0,0 +1,159 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/exceptions"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormFeatureFlagRepository guarda los flags en la base de datos principal,
+// junto al catálogo de tenants.
+type GormFeatureFlagRepository struct {
+	db *gorm.DB
+}
+
+func NewGormFeatureFlagRepository(db *gorm.DB) *GormFeatureFlagRepository {
+	return &GormFeatureFlagRepository{db: db}
+}
+
+func (r *GormFeatureFlagRepository) Save(ctx context.Context, flag *entities.FeatureFlag) error {
+	db := transaction.DB(ctx, r.db)
+
+	if err := db.Create(toModel(flag)).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+			return exceptions.ErrDuplicateFlag
+		}
+		return shared_exceptions.NewInternalServerError("failed to save feature flag", err.Error())
+	}
+
+	return r.insertOverrides(db, flag)
+}
+
+// Update debe llamarse dentro de una transacción, tras FindByKey: borra y
+// vuelve a insertar los overrides.
+func (r *GormFeatureFlagRepository) Update(ctx context.Context, flag *entities.FeatureFlag) error {
+	db := transaction.DB(ctx, r.db)
+
+	result := db.Model(&FeatureFlagModel{}).
+		Where("key = ?", flag.Key).
+		Updates(map[string]any{
+			"description": flag.Description,
+			"enabled":     flag.Enabled,
+			"rollout":     flag.Rollout,
+			"updated_at":  flag.UpdatedAt,
+		})
+	if result.Error != nil {
+		return shared_exceptions.NewInternalServerError("failed to update feature flag", result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return exceptions.ErrFlagNotFound
+	}
+
+	if err := db.Where("flag_key = ?", flag.Key).Delete(&FeatureFlagOverrideModel{}).Error; err != nil {
+		return shared_exceptions.NewInternalServerError("failed to update feature flag overrides", err.Error())
+	}
+
+	return r.insertOverrides(db, flag)
+}
+
+// FindByKey bloquea el flag (FOR UPDATE) si hay una transacción abierta: dos
+// cambios de overrides concurrentes se serializan en lugar de perder uno al
+// reemplazar el conjunto. Las lecturas fuera de transacción no bloquean.
+func (r *GormFeatureFlagRepository) FindByKey(ctx context.Context, key string) (*entities.FeatureFlag, error) {
+	db := transaction.DB(ctx, r.db)
+
+	query := db
+	if transaction.InTransaction(ctx) {
+		query = db.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	var model FeatureFlagModel
+	if err := query.Where("key = ?", key).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.ErrFlagNotFound
+		}
+		return nil, shared_exceptions.NewInternalServerError("failed to find feature flag", err.Error())
+	}
+
+	var overrides []FeatureFlagOverrideModel
+	if err := db.Where("flag_key = ?", key).Find(&overrides).Error; err != nil {
+		return nil, shared_exceptions.NewInternalServerError("failed to find feature flag overrides", err.Error())
+	}
+
+	return toDomain(&model, overrides), nil
+}
+
+func (r *GormFeatureFlagRepository) List(ctx context.Context) ([]*entities.FeatureFlag, error) {
+	db := transaction.DB(ctx, r.db)
+
+	var models []FeatureFlagModel
+	if err := db.Order("key").Find(&models).Error; err != nil {
+		return nil, shared_exceptions.NewInternalServerError("failed to list feature flags", err.Error())
+	}
+
+	var overrides []FeatureFlagOverrideModel
+	if err := db.Find(&overrides).Error; err != nil {
+		return nil, shared_exceptions.NewInternalServerError("failed to list feature flag overrides", err.Error())
+	}
+
+	byFlag := make(map[string][]FeatureFlagOverrideModel)
+	for _, override := range overrides {
+		byFlag[override.FlagKey] = append(byFlag[override.FlagKey], override)
+	}
+
+	flags := make([]*entities.FeatureFlag, 0, len(models))
+	for i := range models {
+		flags = append(flags, toDomain(&models[i], byFlag[models[i].Key]))
+	}
+	return flags, nil
+}
+
+func (r *GormFeatureFlagRepository) insertOverrides(db *gorm.DB, flag *entities.FeatureFlag) error {
+	if len(flag.Overrides) == 0 {
+		return nil
+	}
+
+	overrides := make([]FeatureFlagOverrideModel, 0, len(flag.Overrides))
+	for tenantID, enabled := range flag.Overrides {
+		overrides = append(overrides, FeatureFlagOverrideModel{FlagKey: flag.Key, TenantID: tenantID, Enabled: enabled})
+	}
+
+	if err := db.Create(&overrides).Error; err != nil {
+		return shared_exceptions.NewInternalServerError("failed to save feature flag overrides", err.Error())
+	}
+	return nil
+}
+
+func toModel(flag *entities.FeatureFlag) *FeatureFlagModel {
+	return &FeatureFlagModel{
+		Key:         flag.Key,
+		Description: flag.Description,
+		Enabled:     flag.Enabled,
+		Rollout:     flag.Rollout,
+		CreatedAt:   flag.CreatedAt,
+		UpdatedAt:   flag.UpdatedAt,
+	}
+}
+
+func toDomain(model *FeatureFlagModel, overrides []FeatureFlagOverrideModel) *entities.FeatureFlag {
+	flag := &entities.FeatureFlag{
+		Key:         model.Key,
+		Description: model.Description,
+		Enabled:     model.Enabled,
+		Rollout:     model.Rollout,
+		Overrides:   make(map[string]bool, len(overrides)),
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+	for _, override := range overrides {
+		flag.Overrides[override.TenantID] = override.Enabled
+	}
+	return flag
+}
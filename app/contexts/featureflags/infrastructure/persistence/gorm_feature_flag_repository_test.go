@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/featureflags/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Necesita TEST_DATABASE_DSN apuntando a una base de datos desechable.
+func openTestDatabase(t *testing.T) *gorm.DB {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	migrator, err := migrations.NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestGormFeatureFlagRepository_ConcurrentOverridesAreNotLost(t *testing.T) {
+	db := openTestDatabase(t)
+	repo := NewGormFeatureFlagRepository(db)
+	ctx := context.Background()
+
+	key := "test_" + uuid.NewString()[:8]
+	flag, err := entities.NewFeatureFlag(key, "", false, 0)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, flag))
+	t.Cleanup(func() {
+		db.Where("flag_key = ?", key).Delete(&FeatureFlagOverrideModel{})
+		db.Where("key = ?", key).Delete(&FeatureFlagModel{})
+	})
+
+	set := commands.NewSetFeatureFlagOverrideUseCase(repo, transaction.NewGormTransactionManager(db))
+
+	const tenants = 10
+	var wg sync.WaitGroup
+	for i := range tenants {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := set.Execute(ctx, commands.SetFeatureFlagOverrideCommand{
+				Key:      key,
+				TenantID: fmt.Sprintf("tenant-%d", i),
+				Enabled:  true,
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	stored, err := repo.FindByKey(ctx, key)
+	require.NoError(t, err)
+	assert.Len(t, stored.Overrides, tenants)
+}
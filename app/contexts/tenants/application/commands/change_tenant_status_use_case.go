@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type ChangeTenantStatusCommand struct {
+	ID            string
+	Status        string
+	CorrelationID string
+}
+
+// ChangeTenantStatusUseCase suspende o reactiva un tenant y emite
+// tenant.suspended o tenant.activated.
+type ChangeTenantStatusUseCase struct {
+	tenantRepo ports.TenantRepository
+	eventBus   shared_ports.EventBus
+	txManager  shared_ports.TransactionManager
+}
+
+func NewChangeTenantStatusUseCase(
+	tenantRepo ports.TenantRepository,
+	eventBus shared_ports.EventBus,
+	txManager shared_ports.TransactionManager,
+) *ChangeTenantStatusUseCase {
+	return &ChangeTenantStatusUseCase{
+		tenantRepo: tenantRepo,
+		eventBus:   eventBus,
+		txManager:  txManager,
+	}
+}
+
+func (h *ChangeTenantStatusUseCase) Execute(ctx context.Context, cmd ChangeTenantStatusCommand) (*entities.Tenant, error) {
+	status := entities.TenantStatus(cmd.Status)
+	if !status.IsValid() {
+		return nil, exceptions.ErrInvalidStatus
+	}
+
+	var tenant *entities.Tenant
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		tenant, err = h.tenantRepo.FindByID(ctx, cmd.ID)
+		if err != nil {
+			return err
+		}
+
+		if status == entities.TenantStatusSuspended {
+			err = tenant.Suspend()
+		} else {
+			err = tenant.Activate()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := h.tenantRepo.Update(ctx, tenant); err != nil {
+			return err
+		}
+
+		var event shared_ports.DomainEvent = events.NewTenantActivatedEvent(tenant)
+		if status == entities.TenantStatusSuspended {
+			event = events.NewTenantSuspendedEvent(tenant)
+		}
+
+		return h.eventBus.Publish(ctx, event, cmd.CorrelationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
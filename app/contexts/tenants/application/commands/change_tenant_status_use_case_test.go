@@ -0,0 +1,96 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeTenantStatusUseCaseSuite struct {
+	suite.Suite
+	repo   *MockTenantRepository
+	event  *MockEventBus
+	tx     *transaction.InMemoryTransactionManager
+	uc     *commands.ChangeTenantStatusUseCase
+	ctx    context.Context
+	tenant *entities.Tenant
+}
+
+func (s *ChangeTenantStatusUseCaseSuite) SetupTest() {
+	s.repo = new(MockTenantRepository)
+	s.event = new(MockEventBus)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.uc = commands.NewChangeTenantStatusUseCase(s.repo, s.event, s.tx)
+	s.ctx = context.Background()
+
+	tenant, err := entities.NewTenant("acme", "Acme", entities.PlanFree)
+	s.Require().NoError(err)
+	s.tenant = tenant
+}
+
+func TestChangeTenantStatusUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ChangeTenantStatusUseCaseSuite))
+}
+
+func (s *ChangeTenantStatusUseCaseSuite) TestExecute_Suspend() {
+	cmd := commands.ChangeTenantStatusCommand{ID: "acme", Status: "suspended", CorrelationID: "corr-1"}
+
+	s.repo.On("FindByID", mock.Anything, "acme").Return(s.tenant, nil).Once()
+	s.repo.On("Update", mock.Anything, s.tenant).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.AnythingOfType("events.TenantSuspendedEvent"), "corr-1").Return(nil).Once()
+
+	tenant, err := s.uc.Execute(s.ctx, cmd)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), entities.TenantStatusSuspended, tenant.Status)
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.repo.AssertExpectations(s.T())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *ChangeTenantStatusUseCaseSuite) TestExecute_Activate() {
+	s.Require().NoError(s.tenant.Suspend())
+
+	s.repo.On("FindByID", mock.Anything, "acme").Return(s.tenant, nil).Once()
+	s.repo.On("Update", mock.Anything, s.tenant).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.AnythingOfType("events.TenantActivatedEvent"), "").Return(nil).Once()
+
+	tenant, err := s.uc.Execute(s.ctx, commands.ChangeTenantStatusCommand{ID: "acme", Status: "active"})
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), tenant.IsActive())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *ChangeTenantStatusUseCaseSuite) TestExecute_AlreadySuspended() {
+	s.Require().NoError(s.tenant.Suspend())
+	s.repo.On("FindByID", mock.Anything, "acme").Return(s.tenant, nil).Once()
+
+	_, err := s.uc.Execute(s.ctx, commands.ChangeTenantStatusCommand{ID: "acme", Status: "suspended"})
+
+	assert.Equal(s.T(), exceptions.ErrTenantSuspended, err)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	s.event.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ChangeTenantStatusUseCaseSuite) TestExecute_InvalidStatus() {
+	_, err := s.uc.Execute(s.ctx, commands.ChangeTenantStatusCommand{ID: "acme", Status: "deleted"})
+
+	assert.Equal(s.T(), exceptions.ErrInvalidStatus, err)
+	s.repo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything)
+}
+
+func (s *ChangeTenantStatusUseCaseSuite) TestExecute_NotFound() {
+	s.repo.On("FindByID", mock.Anything, "ghost").Return(nil, exceptions.ErrTenantNotFound).Once()
+
+	_, err := s.uc.Execute(s.ctx, commands.ChangeTenantStatusCommand{ID: "ghost", Status: "suspended"})
+
+	assert.Equal(s.T(), exceptions.ErrTenantNotFound, err)
+}
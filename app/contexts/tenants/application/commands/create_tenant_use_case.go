@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type CreateTenantCommand struct {
+	ID            string
+	Name          string
+	Plan          string
+	CorrelationID string
+}
+
+type CreateTenantUseCase struct {
+	tenantRepo ports.TenantRepository
+	eventBus   shared_ports.EventBus
+	txManager  shared_ports.TransactionManager
+}
+
+func NewCreateTenantUseCase(
+	tenantRepo ports.TenantRepository,
+	eventBus shared_ports.EventBus,
+	txManager shared_ports.TransactionManager,
+) *CreateTenantUseCase {
+	return &CreateTenantUseCase{
+		tenantRepo: tenantRepo,
+		eventBus:   eventBus,
+		txManager:  txManager,
+	}
+}
+
+func (h *CreateTenantUseCase) Execute(ctx context.Context, cmd CreateTenantCommand) (*entities.Tenant, error) {
+	tenant, err := entities.NewTenant(cmd.ID, cmd.Name, entities.Plan(cmd.Plan))
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.tenantRepo.Save(ctx, tenant); err != nil {
+			return err
+		}
+
+		return h.eventBus.Publish(ctx, events.NewTenantCreatedEvent(tenant), cmd.CorrelationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
@@ -0,0 +1,113 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockTenantRepository struct{ mock.Mock }
+
+func (m *MockTenantRepository) Save(ctx context.Context, tenant *entities.Tenant) error {
+	args := m.Called(ctx, tenant)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepository) Update(ctx context.Context, tenant *entities.Tenant) error {
+	args := m.Called(ctx, tenant)
+	return args.Error(0)
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, id string) (*entities.Tenant, error) {
+	args := m.Called(ctx, id)
+	if t, ok := args.Get(0).(*entities.Tenant); ok {
+		return t, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTenantRepository) List(ctx context.Context, status *entities.TenantStatus) ([]*entities.Tenant, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).([]*entities.Tenant), args.Error(1)
+}
+
+type MockEventBus struct{ mock.Mock }
+
+func (m *MockEventBus) Publish(ctx context.Context, event shared_ports.DomainEvent, correlationID string) error {
+	args := m.Called(ctx, event, correlationID)
+	return args.Error(0)
+}
+func (m *MockEventBus) Close() { m.Called() }
+
+type CreateTenantUseCaseSuite struct {
+	suite.Suite
+	repo  *MockTenantRepository
+	event *MockEventBus
+	tx    *transaction.InMemoryTransactionManager
+	uc    *commands.CreateTenantUseCase
+	ctx   context.Context
+}
+
+func (s *CreateTenantUseCaseSuite) SetupTest() {
+	s.repo = new(MockTenantRepository)
+	s.event = new(MockEventBus)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.uc = commands.NewCreateTenantUseCase(s.repo, s.event, s.tx)
+	s.ctx = context.Background()
+}
+
+func TestCreateTenantUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CreateTenantUseCaseSuite))
+}
+
+func (s *CreateTenantUseCaseSuite) TestExecute_Success() {
+	cmd := commands.CreateTenantCommand{ID: "acme", Name: "Acme", Plan: "standard", CorrelationID: "corr-1"}
+
+	s.repo.On("Save", mock.Anything, mock.AnythingOfType("*entities.Tenant")).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.AnythingOfType("events.TenantCreatedEvent"), "corr-1").Return(nil).Once()
+
+	tenant, err := s.uc.Execute(s.ctx, cmd)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "acme", tenant.ID)
+	assert.True(s.T(), tenant.IsActive())
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.repo.AssertExpectations(s.T())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *CreateTenantUseCaseSuite) TestExecute_InvalidPlan() {
+	_, err := s.uc.Execute(s.ctx, commands.CreateTenantCommand{ID: "acme", Name: "Acme", Plan: "gold"})
+
+	assert.Equal(s.T(), exceptions.ErrInvalidPlan, err)
+	s.repo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+}
+
+func (s *CreateTenantUseCaseSuite) TestExecute_DuplicateTenant() {
+	s.repo.On("Save", mock.Anything, mock.Anything).Return(exceptions.ErrDuplicateTenant).Once()
+
+	_, err := s.uc.Execute(s.ctx, commands.CreateTenantCommand{ID: "acme", Name: "Acme", Plan: "free"})
+
+	assert.Equal(s.T(), exceptions.ErrDuplicateTenant, err)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+	s.event.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *CreateTenantUseCaseSuite) TestExecute_PublishFailureRollsBack() {
+	s.repo.On("Save", mock.Anything, mock.Anything).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("outbox down")).Once()
+
+	_, err := s.uc.Execute(s.ctx, commands.CreateTenantCommand{ID: "acme", Name: "Acme", Plan: "free"})
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+}
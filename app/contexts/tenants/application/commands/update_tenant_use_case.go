@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// UpdateTenantCommand usa punteros para distinguir "no modificar" (nil) de un
+// valor nuevo.
+type UpdateTenantCommand struct {
+	ID   string
+	Name *string
+	Plan *string
+}
+
+// UpdateTenantUseCase cambia nombre y plan. No emite eventos: solo
+// tenant.created y los cambios de estado interesan fuera del contexto.
+type UpdateTenantUseCase struct {
+	tenantRepo ports.TenantRepository
+	txManager  shared_ports.TransactionManager
+}
+
+func NewUpdateTenantUseCase(
+	tenantRepo ports.TenantRepository,
+	txManager shared_ports.TransactionManager,
+) *UpdateTenantUseCase {
+	return &UpdateTenantUseCase{
+		tenantRepo: tenantRepo,
+		txManager:  txManager,
+	}
+}
+
+func (h *UpdateTenantUseCase) Execute(ctx context.Context, cmd UpdateTenantCommand) (*entities.Tenant, error) {
+	if cmd.Name == nil && cmd.Plan == nil {
+		return nil, exceptions.ErrEmptyUpdate
+	}
+
+	var tenant *entities.Tenant
+
+	err := h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		tenant, err = h.tenantRepo.FindByID(ctx, cmd.ID)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Name != nil {
+			if err := tenant.Rename(*cmd.Name); err != nil {
+				return err
+			}
+		}
+		if cmd.Plan != nil {
+			if err := tenant.ChangePlan(entities.Plan(*cmd.Plan)); err != nil {
+				return err
+			}
+		}
+
+		return h.tenantRepo.Update(ctx, tenant)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
@@ -0,0 +1,31 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/events"
+)
+
+// TenantNotificationHandler avisa a operaciones de las altas y suspensiones
+// de tenants.
+type TenantNotificationHandler struct{}
+
+func NewTenantNotificationHandler() *TenantNotificationHandler {
+	return &TenantNotificationHandler{}
+}
+
+func (h *TenantNotificationHandler) HandleCreated(ctx context.Context, event *events.TenantCreatedEvent) error {
+	fmt.Printf("Notifying operations: tenant %s (%s) created on plan %s\n", event.Data.ID, event.Data.Name, event.Data.Plan)
+	return nil
+}
+
+func (h *TenantNotificationHandler) HandleSuspended(ctx context.Context, event *events.TenantSuspendedEvent) error {
+	fmt.Printf("Notifying operations: tenant %s suspended\n", event.Data.ID)
+	return nil
+}
+
+func (h *TenantNotificationHandler) HandleActivated(ctx context.Context, event *events.TenantActivatedEvent) error {
+	fmt.Printf("Notifying operations: tenant %s activated\n", event.Data.ID)
+	return nil
+}
@@ -0,0 +1,24 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/ports"
+)
+
+type GetTenantQuery struct {
+	ID string
+}
+
+type GetTenantUseCase struct {
+	tenantRepo ports.TenantRepository
+}
+
+func NewGetTenantUseCase(tenantRepo ports.TenantRepository) *GetTenantUseCase {
+	return &GetTenantUseCase{tenantRepo: tenantRepo}
+}
+
+func (h *GetTenantUseCase) Execute(ctx context.Context, query GetTenantQuery) (*entities.Tenant, error) {
+	return h.tenantRepo.FindByID(ctx, query.ID)
+}
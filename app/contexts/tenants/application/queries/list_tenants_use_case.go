@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/ports"
+)
+
+// ListTenantsQuery filtra por estado si Status no está vacío. No pagina: el
+// número de tenants es pequeño comparado con el de usuarios.
+type ListTenantsQuery struct {
+	Status string
+}
+
+type ListTenantsResponse struct {
+	Items []*entities.Tenant `json:"items"`
+}
+
+type ListTenantsUseCase struct {
+	tenantRepo ports.TenantRepository
+}
+
+func NewListTenantsUseCase(tenantRepo ports.TenantRepository) *ListTenantsUseCase {
+	return &ListTenantsUseCase{tenantRepo: tenantRepo}
+}
+
+func (h *ListTenantsUseCase) Execute(ctx context.Context, query ListTenantsQuery) (*ListTenantsResponse, error) {
+	var status *entities.TenantStatus
+	if query.Status != "" {
+		s := entities.TenantStatus(query.Status)
+		if !s.IsValid() {
+			return nil, exceptions.ErrInvalidStatus
+		}
+		status = &s
+	}
+
+	tenants, err := h.tenantRepo.List(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListTenantsResponse{Items: tenants}, nil
+}
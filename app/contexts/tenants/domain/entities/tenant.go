@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+)
+
+type TenantStatus string
+
+const (
+	TenantStatusActive    TenantStatus = "active"
+	TenantStatusSuspended TenantStatus = "suspended"
+)
+
+func (s TenantStatus) IsValid() bool {
+	return s == TenantStatusActive || s == TenantStatusSuspended
+}
+
+type Plan string
+
+const (
+	PlanFree       Plan = "free"
+	PlanStandard   Plan = "standard"
+	PlanEnterprise Plan = "enterprise"
+)
+
+func (p Plan) IsValid() bool {
+	switch p {
+	case PlanFree, PlanStandard, PlanEnterprise:
+		return true
+	default:
+		return false
+	}
+}
+
+// El ID del tenant viaja en X-Tenant-Id, en los tokens y en los nombres de
+// esquema, así que se limita a un slug.
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,99}$`)
+
+type Tenant struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Status    TenantStatus `json:"status"`
+	Plan      Plan         `json:"plan"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+func NewTenant(id, name string, plan Plan) (*Tenant, error) {
+	if !tenantIDPattern.MatchString(id) {
+		return nil, exceptions.ErrInvalidTenantID
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, exceptions.ErrInvalidTenantName
+	}
+
+	if !plan.IsValid() {
+		return nil, exceptions.ErrInvalidPlan
+	}
+
+	now := time.Now()
+	return &Tenant{
+		ID:        id,
+		Name:      name,
+		Status:    TenantStatusActive,
+		Plan:      plan,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (t *Tenant) IsActive() bool {
+	return t.Status == TenantStatusActive
+}
+
+func (t *Tenant) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return exceptions.ErrInvalidTenantName
+	}
+
+	t.Name = name
+	t.touch()
+	return nil
+}
+
+func (t *Tenant) ChangePlan(plan Plan) error {
+	if !plan.IsValid() {
+		return exceptions.ErrInvalidPlan
+	}
+
+	t.Plan = plan
+	t.touch()
+	return nil
+}
+
+// Suspend bloquea al tenant: TenantMiddleware rechaza sus peticiones, pero
+// sus datos se conservan.
+func (t *Tenant) Suspend() error {
+	if !t.IsActive() {
+		return exceptions.ErrTenantSuspended
+	}
+
+	t.Status = TenantStatusSuspended
+	t.touch()
+	return nil
+}
+
+func (t *Tenant) Activate() error {
+	if t.IsActive() {
+		return exceptions.ErrTenantAlreadyActive
+	}
+
+	t.Status = TenantStatusActive
+	t.touch()
+	return nil
+}
+
+func (t *Tenant) touch() {
+	t.UpdatedAt = time.Now()
+}
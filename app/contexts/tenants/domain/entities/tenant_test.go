@@ -0,0 +1,54 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTenant(t *testing.T) {
+	tenant, err := entities.NewTenant("acme", "  Acme Corp ", entities.PlanStandard)
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme", tenant.ID)
+	assert.Equal(t, "Acme Corp", tenant.Name)
+	assert.Equal(t, entities.TenantStatusActive, tenant.Status)
+	assert.Equal(t, entities.PlanStandard, tenant.Plan)
+}
+
+func TestNewTenant_Validation(t *testing.T) {
+	cases := map[string]struct {
+		id, name string
+		plan     entities.Plan
+		err      error
+	}{
+		"uppercase id":   {"Acme", "Acme", entities.PlanFree, exceptions.ErrInvalidTenantID},
+		"id with spaces": {"acme corp", "Acme", entities.PlanFree, exceptions.ErrInvalidTenantID},
+		"one char id":    {"a", "Acme", entities.PlanFree, exceptions.ErrInvalidTenantID},
+		"empty name":     {"acme", "  ", entities.PlanFree, exceptions.ErrInvalidTenantName},
+		"unknown plan":   {"acme", "Acme", "gold", exceptions.ErrInvalidPlan},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := entities.NewTenant(tc.id, tc.name, tc.plan)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTenant_SuspendAndActivate(t *testing.T) {
+	tenant, err := entities.NewTenant("acme", "Acme", entities.PlanFree)
+	require.NoError(t, err)
+
+	require.NoError(t, tenant.Suspend())
+	assert.False(t, tenant.IsActive())
+	assert.Equal(t, exceptions.ErrTenantSuspended, tenant.Suspend())
+
+	require.NoError(t, tenant.Activate())
+	assert.True(t, tenant.IsActive())
+	assert.Equal(t, exceptions.ErrTenantAlreadyActive, tenant.Activate())
+}
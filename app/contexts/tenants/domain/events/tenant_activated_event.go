@@ -0,0 +1,34 @@
+package events
+
+import (
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+)
+
+const (
+	TenantActivatedEventType    = "tenant.activated"
+	TenantActivatedEventVersion = 1
+)
+
+// TenantActivatedData se emite al reactivar un tenant suspendido.
+type TenantActivatedData struct {
+	ID          string    `json:"id"`
+	ActivatedAt time.Time `json:"activated_at"`
+}
+
+type TenantActivatedEvent struct {
+	shared_events.BaseEvent
+	Data TenantActivatedData `json:"data"`
+}
+
+func NewTenantActivatedEvent(tenant *entities.Tenant) TenantActivatedEvent {
+	return TenantActivatedEvent{
+		BaseEvent: shared_events.NewBaseEvent(TenantActivatedEventType, TenantActivatedEventVersion, tenant.ID),
+		Data: TenantActivatedData{
+			ID:          tenant.ID,
+			ActivatedAt: tenant.UpdatedAt.UTC(),
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+)
+
+const (
+	TenantCreatedEventType    = "tenant.created"
+	TenantCreatedEventVersion = 1
+)
+
+// TenantCreatedData identifica al tenant con id y no con tenant_id: los
+// consumidores ponen tenant_id en el contexto y estos eventos pertenecen al
+// plano de control, no a los datos del tenant.
+type TenantCreatedData struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Plan      string    `json:"plan"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TenantCreatedEvent struct {
+	shared_events.BaseEvent
+	Data TenantCreatedData `json:"data"`
+}
+
+func NewTenantCreatedEvent(tenant *entities.Tenant) TenantCreatedEvent {
+	return TenantCreatedEvent{
+		BaseEvent: shared_events.NewBaseEvent(TenantCreatedEventType, TenantCreatedEventVersion, tenant.ID),
+		Data: TenantCreatedData{
+			ID:        tenant.ID,
+			Name:      tenant.Name,
+			Plan:      string(tenant.Plan),
+			CreatedAt: tenant.CreatedAt.UTC(),
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package events
+
+import (
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+)
+
+const (
+	TenantSuspendedEventType    = "tenant.suspended"
+	TenantSuspendedEventVersion = 1
+)
+
+type TenantSuspendedData struct {
+	ID          string    `json:"id"`
+	SuspendedAt time.Time `json:"suspended_at"`
+}
+
+type TenantSuspendedEvent struct {
+	shared_events.BaseEvent
+	Data TenantSuspendedData `json:"data"`
+}
+
+func NewTenantSuspendedEvent(tenant *entities.Tenant) TenantSuspendedEvent {
+	return TenantSuspendedEvent{
+		BaseEvent: shared_events.NewBaseEvent(TenantSuspendedEventType, TenantSuspendedEventVersion, tenant.ID),
+		Data: TenantSuspendedData{
+			ID:          tenant.ID,
+			SuspendedAt: tenant.UpdatedAt.UTC(),
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package exceptions
+
+import base_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+
+var (
+	ErrTenantNotFound      = base_exceptions.NewNotFoundError("tenant not found", "")
+	ErrDuplicateTenant     = base_exceptions.NewConflictError("tenant already exists", "")
+	ErrInvalidTenantID     = base_exceptions.NewBadRequestError("invalid tenant id", "id must be 2-100 lowercase letters, digits, '-' or '_' and start with a letter or digit")
+	ErrInvalidTenantName   = base_exceptions.NewBadRequestError("tenant name cannot be empty", "")
+	ErrInvalidPlan         = base_exceptions.NewBadRequestError("invalid plan", "plan must be 'free', 'standard' or 'enterprise'")
+	ErrInvalidStatus       = base_exceptions.NewBadRequestError("invalid status", "status must be 'active' or 'suspended'")
+	ErrEmptyUpdate         = base_exceptions.NewBadRequestError("no fields to update", "")
+	ErrTenantSuspended     = base_exceptions.NewConflictError("tenant is already suspended", "")
+	ErrTenantAlreadyActive = base_exceptions.NewConflictError("tenant is already active", "")
+)
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+)
+
+type TenantRepository interface {
+	Save(ctx context.Context, tenant *entities.Tenant) error
+	Update(ctx context.Context, tenant *entities.Tenant) error
+	FindByID(ctx context.Context, id string) (*entities.Tenant, error)
+	List(ctx context.Context, status *entities.TenantStatus) ([]*entities.Tenant, error)
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// maxEntries acota la memoria si llegan muchos X-Tenant-Id inventados: los
+// tenants desconocidos también se cachean.
+const maxEntries = 10_000
+
+// unknownTenantTTL es la caducidad de los tenants desconocidos, mucho menor
+// que la de los existentes: un tenant recién creado debe poder usarse casi de
+// inmediato en todas las réplicas.
+const unknownTenantTTL = time.Second
+
+type entry struct {
+	access    shared_ports.TenantAccess
+	expiresAt time.Time
+}
+
+// CachedTenantLookup implementa shared_ports.TenantLookup sobre el
+// repositorio de tenants con una caché en memoria por proceso. Un cambio de
+// estado tarda como mucho ttl en verse en cada réplica; un tenant nuevo, como
+// mucho unknownTenantTTL.
+type CachedTenantLookup struct {
+	tenantRepo ports.TenantRepository
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+func NewCachedTenantLookup(tenantRepo ports.TenantRepository, ttl time.Duration) *CachedTenantLookup {
+	return &CachedTenantLookup{
+		tenantRepo: tenantRepo,
+		ttl:        ttl,
+		now:        time.Now,
+		entries:    make(map[string]entry),
+	}
+}
+
+func (l *CachedTenantLookup) Access(ctx context.Context, tenantID string) (shared_ports.TenantAccess, error) {
+	now := l.now()
+
+	l.mu.Lock()
+	cached, ok := l.entries[tenantID]
+	l.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.access, nil
+	}
+
+	access := shared_ports.TenantUnknown
+	ttl := min(l.ttl, unknownTenantTTL)
+	tenant, err := l.tenantRepo.FindByID(ctx, tenantID)
+	switch {
+	case errors.Is(err, exceptions.ErrTenantNotFound):
+	case err != nil:
+		return shared_ports.TenantUnknown, err
+	case tenant.IsActive():
+		access, ttl = shared_ports.TenantActive, l.ttl
+	default:
+		access, ttl = shared_ports.TenantSuspended, l.ttl
+	}
+
+	l.store(tenantID, entry{access: access, expiresAt: now.Add(ttl)}, now)
+	return access, nil
+}
+
+func (l *CachedTenantLookup) store(tenantID string, e entry, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.entries) >= maxEntries {
+		for id, cached := range l.entries {
+			if !now.Before(cached.expiresAt) {
+				delete(l.entries, id)
+			}
+		}
+		if len(l.entries) >= maxEntries {
+			l.entries = make(map[string]entry)
+		}
+	}
+
+	l.entries[tenantID] = e
+}
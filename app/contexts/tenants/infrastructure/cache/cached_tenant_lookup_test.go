@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTenantRepository struct {
+	tenants map[string]*entities.Tenant
+	err     error
+	calls   int
+}
+
+func (r *fakeTenantRepository) Save(ctx context.Context, tenant *entities.Tenant) error   { return nil }
+func (r *fakeTenantRepository) Update(ctx context.Context, tenant *entities.Tenant) error { return nil }
+
+func (r *fakeTenantRepository) FindByID(ctx context.Context, id string) (*entities.Tenant, error) {
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	if tenant, ok := r.tenants[id]; ok {
+		return tenant, nil
+	}
+	return nil, exceptions.ErrTenantNotFound
+}
+
+func (r *fakeTenantRepository) List(ctx context.Context, status *entities.TenantStatus) ([]*entities.Tenant, error) {
+	return nil, nil
+}
+
+func newLookup(t *testing.T) (*CachedTenantLookup, *fakeTenantRepository, *time.Time) {
+	tenant, err := entities.NewTenant("acme", "Acme", entities.PlanFree)
+	require.NoError(t, err)
+
+	repo := &fakeTenantRepository{tenants: map[string]*entities.Tenant{"acme": tenant}}
+	lookup := NewCachedTenantLookup(repo, 30*time.Second)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lookup.now = func() time.Time { return now }
+
+	return lookup, repo, &now
+}
+
+func TestCachedTenantLookup_CachesUntilTTL(t *testing.T) {
+	lookup, repo, now := newLookup(t)
+	ctx := context.Background()
+
+	access, err := lookup.Access(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, shared_ports.TenantActive, access)
+
+	// La suspensión no se ve hasta que caduca la entrada
+	require.NoError(t, repo.tenants["acme"].Suspend())
+	access, _ = lookup.Access(ctx, "acme")
+	assert.Equal(t, shared_ports.TenantActive, access)
+	assert.Equal(t, 1, repo.calls)
+
+	*now = now.Add(31 * time.Second)
+	access, _ = lookup.Access(ctx, "acme")
+	assert.Equal(t, shared_ports.TenantSuspended, access)
+	assert.Equal(t, 2, repo.calls)
+}
+
+func TestCachedTenantLookup_CachesUnknownTenants(t *testing.T) {
+	lookup, repo, _ := newLookup(t)
+
+	for range 3 {
+		access, err := lookup.Access(context.Background(), "ghost")
+		require.NoError(t, err)
+		assert.Equal(t, shared_ports.TenantUnknown, access)
+	}
+
+	assert.Equal(t, 1, repo.calls)
+}
+
+func TestCachedTenantLookup_NewTenantIsSeenAfterShortTTL(t *testing.T) {
+	lookup, repo, now := newLookup(t)
+	ctx := context.Background()
+
+	access, _ := lookup.Access(ctx, "globex")
+	assert.Equal(t, shared_ports.TenantUnknown, access)
+
+	tenant, err := entities.NewTenant("globex", "Globex", entities.PlanFree)
+	require.NoError(t, err)
+	repo.tenants["globex"] = tenant
+
+	*now = now.Add(2 * time.Second)
+	access, _ = lookup.Access(ctx, "globex")
+	assert.Equal(t, shared_ports.TenantActive, access)
+}
+
+func TestCachedTenantLookup_RecognizesWrappedNotFound(t *testing.T) {
+	lookup, repo, _ := newLookup(t)
+	repo.err = fmt.Errorf("find tenant: %w", exceptions.ErrTenantNotFound)
+
+	access, err := lookup.Access(context.Background(), "ghost")
+	require.NoError(t, err)
+	assert.Equal(t, shared_ports.TenantUnknown, access)
+}
+
+func TestCachedTenantLookup_DoesNotCacheErrors(t *testing.T) {
+	lookup, repo, _ := newLookup(t)
+	repo.err = errors.New("connection refused")
+
+	_, err := lookup.Access(context.Background(), "acme")
+	assert.Error(t, err)
+
+	repo.err = nil
+	access, err := lookup.Access(context.Background(), "acme")
+	require.NoError(t, err)
+	assert.Equal(t, shared_ports.TenantActive, access)
+}
@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/commands"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ChangeTenantStatusController atiende /suspend y /activate; status es el
+// estado al que pasa el tenant.
+func ChangeTenantStatusController(useCase *commands.ChangeTenantStatusUseCase, status string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		correlationID, _ := c.Locals("correlation_id").(string)
+
+		cmd := commands.ChangeTenantStatusCommand{
+			ID:            c.Params("id"),
+			Status:        status,
+			CorrelationID: correlationID,
+		}
+
+		tenant, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(tenant)
+	}
+}
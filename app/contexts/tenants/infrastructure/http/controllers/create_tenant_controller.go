@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/commands"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreateTenantRequest struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Plan string `json:"plan"`
+}
+
+func CreateTenantController(useCase *commands.CreateTenantUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req CreateTenantRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return shared_exceptions.NewBadRequestError("invalid request body", err.Error())
+		}
+
+		correlationID, _ := c.Locals("correlation_id").(string)
+
+		cmd := commands.CreateTenantCommand{
+			ID:            req.ID,
+			Name:          req.Name,
+			Plan:          req.Plan,
+			CorrelationID: correlationID,
+		}
+
+		tenant, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(tenant)
+	}
+}
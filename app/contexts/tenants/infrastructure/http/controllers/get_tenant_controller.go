@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/queries"
+	"github.com/gofiber/fiber/v2"
+)
+
+func GetTenantController(useCase *queries.GetTenantUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		tenant, err := useCase.Execute(c.UserContext(), queries.GetTenantQuery{ID: c.Params("id")})
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(tenant)
+	}
+}
@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/queries"
+	"github.com/gofiber/fiber/v2"
+)
+
+func ListTenantsController(useCase *queries.ListTenantsUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		response, err := useCase.Execute(c.UserContext(), queries.ListTenantsQuery{Status: c.Query("status")})
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(response)
+	}
+}
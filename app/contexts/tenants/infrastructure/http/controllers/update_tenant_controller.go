@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/commands"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UpdateTenantRequest struct {
+	Name *string `json:"name,omitempty"`
+	Plan *string `json:"plan,omitempty"`
+}
+
+func UpdateTenantController(useCase *commands.UpdateTenantUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req UpdateTenantRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return shared_exceptions.NewBadRequestError("invalid request body", err.Error())
+		}
+
+		cmd := commands.UpdateTenantCommand{
+			ID:   c.Params("id"),
+			Name: req.Name,
+			Plan: req.Plan,
+		}
+
+		tenant, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(tenant)
+	}
+}
@@ -0,0 +1,63 @@
+package routes
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/queries"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/infrastructure/http/controllers"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterTenantRoutes registra la administración de tenants. Solo la usan
+// los platform_admin y no se acota al tenant del token: debe registrarse
+// antes de TenantScopeMiddleware.
+func RegisterTenantRoutes(
+	app fiber.Router,
+	rateLimiter *middleware.RateLimiter,
+	createUseCase *commands.CreateTenantUseCase,
+	getUseCase *queries.GetTenantUseCase,
+	listUseCase *queries.ListTenantsUseCase,
+	updateUseCase *commands.UpdateTenantUseCase,
+	changeStatusUseCase *commands.ChangeTenantStatusUseCase,
+) {
+
+	tenants := app.Group("/v1/tenants")
+
+	tenants.Post("/",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionTenantsManage),
+		controllers.CreateTenantController(createUseCase),
+	)
+
+	tenants.Get("/",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionTenantsRead),
+		controllers.ListTenantsController(listUseCase),
+	)
+
+	tenants.Get("/:id",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionTenantsRead),
+		controllers.GetTenantController(getUseCase),
+	)
+
+	tenants.Patch("/:id",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionTenantsManage),
+		controllers.UpdateTenantController(updateUseCase),
+	)
+
+	tenants.Post("/:id/suspend",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionTenantsManage),
+		controllers.ChangeTenantStatusController(changeStatusUseCase, string(entities.TenantStatusSuspended)),
+	)
+
+	tenants.Post("/:id/activate",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionTenantsManage),
+		controllers.ChangeTenantStatusController(changeStatusUseCase, string(entities.TenantStatusActive)),
+	)
+}
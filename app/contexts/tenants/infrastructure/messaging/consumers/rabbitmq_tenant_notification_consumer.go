@@ -0,0 +1,56 @@
+package consumers
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/application/notifications"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/inbox"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/messaging"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/rabbitmq"
+	"go.uber.org/zap"
+)
+
+type RabbitMQTenantNotificationConsumer struct {
+	consumer ports.EventConsumer
+}
+
+func NewRabbitMQTenantNotificationConsumer(
+	cfg *config.RabbitMQConfig,
+	logger *zap.Logger,
+	inboxRepository ports.InboxRepository,
+	txManager ports.TransactionManager,
+	notificationHandler *notifications.TenantNotificationHandler,
+) *RabbitMQTenantNotificationConsumer {
+
+	registry := messaging.NewEventRegistry()
+	messaging.Register(registry, events.TenantCreatedEventType, notificationHandler.HandleCreated)
+	messaging.Register(registry, events.TenantSuspendedEventType, notificationHandler.HandleSuspended)
+	messaging.Register(registry, events.TenantActivatedEventType, notificationHandler.HandleActivated)
+
+	// El nombre de la cola identifica al consumidor en el inbox
+	queueName := "tenant_notifications"
+
+	consumer := rabbitmq.NewRabbitMQConsumer(
+		cfg,
+		logger,
+		"domain_events",
+		queueName,
+		registry.EventTypes(),
+		inbox.NewInboxEventHandler(queueName, inboxRepository, txManager, registry),
+	)
+
+	return &RabbitMQTenantNotificationConsumer{
+		consumer: consumer,
+	}
+}
+
+func (c *RabbitMQTenantNotificationConsumer) Start(ctx context.Context) error {
+	return c.consumer.Start(ctx)
+}
+
+func (c *RabbitMQTenantNotificationConsumer) Stop() error {
+	return c.consumer.Stop()
+}
@@ -0,0 +1,107 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/tenants/domain/exceptions"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"gorm.io/gorm"
+)
+
+// GormTenantRepository guarda los tenants en la base de datos principal, que
+// en los modos de tenancy aislados hace de plano de control.
+type GormTenantRepository struct {
+	db *gorm.DB
+}
+
+func NewGormTenantRepository(db *gorm.DB) *GormTenantRepository {
+	return &GormTenantRepository{db: db}
+}
+
+func (r *GormTenantRepository) Save(ctx context.Context, tenant *entities.Tenant) error {
+	model := &TenantModel{
+		ID:        tenant.ID,
+		Name:      tenant.Name,
+		Status:    string(tenant.Status),
+		Plan:      string(tenant.Plan),
+		CreatedAt: tenant.CreatedAt,
+		UpdatedAt: tenant.UpdatedAt,
+	}
+
+	if err := transaction.DB(ctx, r.db).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+			return exceptions.ErrDuplicateTenant
+		}
+		return shared_exceptions.NewInternalServerError("failed to save tenant", err.Error())
+	}
+
+	return nil
+}
+
+func (r *GormTenantRepository) Update(ctx context.Context, tenant *entities.Tenant) error {
+	result := transaction.DB(ctx, r.db).
+		Model(&TenantModel{}).
+		Where("id = ?", tenant.ID).
+		Updates(map[string]any{
+			"name":       tenant.Name,
+			"status":     string(tenant.Status),
+			"plan":       string(tenant.Plan),
+			"updated_at": tenant.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return shared_exceptions.NewInternalServerError("failed to update tenant", result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return exceptions.ErrTenantNotFound
+	}
+
+	return nil
+}
+
+func (r *GormTenantRepository) FindByID(ctx context.Context, id string) (*entities.Tenant, error) {
+	var model TenantModel
+
+	err := transaction.DB(ctx, r.db).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exceptions.ErrTenantNotFound
+		}
+		return nil, shared_exceptions.NewInternalServerError("failed to find tenant", err.Error())
+	}
+
+	return toDomain(&model), nil
+}
+
+func (r *GormTenantRepository) List(ctx context.Context, status *entities.TenantStatus) ([]*entities.Tenant, error) {
+	query := transaction.DB(ctx, r.db).Model(&TenantModel{})
+	if status != nil {
+		query = query.Where("status = ?", string(*status))
+	}
+
+	var models []TenantModel
+	if err := query.Order("id").Find(&models).Error; err != nil {
+		return nil, shared_exceptions.NewInternalServerError("failed to list tenants", err.Error())
+	}
+
+	tenants := make([]*entities.Tenant, 0, len(models))
+	for i := range models {
+		tenants = append(tenants, toDomain(&models[i]))
+	}
+	return tenants, nil
+}
+
+func toDomain(model *TenantModel) *entities.Tenant {
+	return &entities.Tenant{
+		ID:        model.ID,
+		Name:      model.Name,
+		Status:    entities.TenantStatus(model.Status),
+		Plan:      entities.Plan(model.Plan),
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package persistence
+
+import "time"
+
+type TenantModel struct {
+	ID        string    `gorm:"type:varchar(100);primaryKey"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	Status    string    `gorm:"type:varchar(20);not null;index:idx_tenants_status"`
+	Plan      string    `gorm:"type:varchar(50);not null"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;not null"`
+}
+
+func (TenantModel) TableName() string {
+	return "tenants"
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+type CreateAdminCommand struct {
+	TenantID string
+	Name     string
+	Email    string
+	Password string
+	Role     authorization.Role
+}
+
+// CreateAdminUseCase da de alta un administrador desde cmd/create-admin. Es la
+// única forma de conseguir el primer platform_admin o tenant_admin: la API
+// solo crea usuarios member.
+type CreateAdminUseCase struct {
+	userRepo  ports.UserRepository
+	eventBus  shared_ports.EventBus
+	hasher    shared_ports.Hasher
+	txManager shared_ports.TransactionManager
+}
+
+func NewCreateAdminUseCase(
+	userRepo ports.UserRepository,
+	eventBus shared_ports.EventBus,
+	hasher shared_ports.Hasher,
+	txManager shared_ports.TransactionManager,
+) *CreateAdminUseCase {
+	return &CreateAdminUseCase{
+		userRepo:  userRepo,
+		eventBus:  eventBus,
+		hasher:    hasher,
+		txManager: txManager,
+	}
+}
+
+func (h *CreateAdminUseCase) Execute(ctx context.Context, cmd CreateAdminCommand) (*CreateUserResponse, error) {
+	email, err := value_objects.NewEmail(cmd.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := value_objects.NewPassword(h.hasher, cmd.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := entities.NewUser(cmd.TenantID, cmd.Name, email, password, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := user.PromoteToAdmin(cmd.Role); err != nil {
+		return nil, err
+	}
+
+	// user.created lleva el rol, así que la proyección y los consumidores lo
+	// ven como cualquier otro alta
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		exists, err := h.userRepo.ExistsByEmail(ctx, cmd.TenantID, email)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return exceptions.ErrDuplicateEmail
+		}
+
+		if err := h.userRepo.Save(ctx, user); err != nil {
+			return err
+		}
+
+		return h.eventBus.Publish(ctx, events.NewUserCreatedEvent(user), "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateUserResponse{UserID: user.ID}, nil
+}
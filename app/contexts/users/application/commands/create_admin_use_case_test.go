@@ -0,0 +1,115 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	user_exceptions "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateAdminUseCaseSuite struct {
+	suite.Suite
+	repo   *MockUserRepository
+	event  *MockEventBus
+	hasher *MockHasher
+	tx     *transaction.InMemoryTransactionManager
+	uc     *commands.CreateAdminUseCase
+	ctx    context.Context
+}
+
+func (s *CreateAdminUseCaseSuite) SetupTest() {
+	s.repo = new(MockUserRepository)
+	s.event = new(MockEventBus)
+	s.hasher = new(MockHasher)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.uc = commands.NewCreateAdminUseCase(s.repo, s.event, s.hasher, s.tx)
+	s.ctx = context.Background()
+}
+
+func TestCreateAdminUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CreateAdminUseCaseSuite))
+}
+
+func (s *CreateAdminUseCaseSuite) command(role authorization.Role) commands.CreateAdminCommand {
+	return commands.CreateAdminCommand{
+		TenantID: "platform",
+		Name:     "Ops",
+		Email:    "ops@example.com",
+		Password: "StrongPass1",
+		Role:     role,
+	}
+}
+
+func (s *CreateAdminUseCaseSuite) TestExecute_CreatesPlatformAdmin() {
+	cmd := s.command(authorization.RolePlatformAdmin)
+
+	s.hasher.On("Hash", cmd.Password).Return("hashed", nil).Once()
+	s.repo.On("ExistsByEmail", mock.Anything, cmd.TenantID, mock.Anything).Return(false, nil).Once()
+	s.repo.On("Save", mock.Anything, mock.MatchedBy(func(user *entities.User) bool {
+		return user.Role == authorization.RolePlatformAdmin && user.TenantID == "platform"
+	})).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, "").Return(nil).Run(func(args mock.Arguments) {
+		// El rol viaja en user.created para que la proyección lo recoja
+		evt := args.Get(1).(events.UserCreatedEvent)
+		s.Equal(string(authorization.RolePlatformAdmin), evt.Data.Role)
+	}).Once()
+
+	response, err := s.uc.Execute(s.ctx, cmd)
+
+	s.Require().NoError(err)
+	s.NotEmpty(response.UserID)
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.repo.AssertExpectations(s.T())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *CreateAdminUseCaseSuite) TestExecute_CreatesTenantAdmin() {
+	cmd := s.command(authorization.RoleTenantAdmin)
+	cmd.TenantID = "acme"
+
+	s.hasher.On("Hash", cmd.Password).Return("hashed", nil).Once()
+	s.repo.On("ExistsByEmail", mock.Anything, cmd.TenantID, mock.Anything).Return(false, nil).Once()
+	s.repo.On("Save", mock.Anything, mock.MatchedBy(func(user *entities.User) bool {
+		return user.Role == authorization.RoleTenantAdmin
+	})).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, "").Return(nil).Once()
+
+	_, err := s.uc.Execute(s.ctx, cmd)
+
+	s.Require().NoError(err)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *CreateAdminUseCaseSuite) TestExecute_RejectsNonAdminRole() {
+	for _, role := range []authorization.Role{authorization.RoleMember, "root"} {
+		s.hasher.On("Hash", mock.Anything).Return("hashed", nil)
+
+		_, err := s.uc.Execute(s.ctx, s.command(role))
+
+		s.Equal(user_exceptions.ErrInvalidAdminRole, err, "role %s", role)
+	}
+	s.repo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+	assert.Equal(s.T(), 0, s.tx.Commits())
+}
+
+func (s *CreateAdminUseCaseSuite) TestExecute_DuplicateEmail() {
+	cmd := s.command(authorization.RolePlatformAdmin)
+
+	s.hasher.On("Hash", cmd.Password).Return("hashed", nil).Once()
+	s.repo.On("ExistsByEmail", mock.Anything, cmd.TenantID, mock.Anything).Return(true, nil).Once()
+
+	_, err := s.uc.Execute(s.ctx, cmd)
+
+	s.Equal(user_exceptions.ErrDuplicateEmail, err)
+	s.repo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+	s.event.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+}
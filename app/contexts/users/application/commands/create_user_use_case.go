@@ -32,6 +32,8 @@ type CreateUserUseCase struct {
 	idempotencyRepo shared_ports.IdempotencyRepository
 	eventBus        shared_ports.EventBus
 	hasher          shared_ports.Hasher
+	txManager       shared_ports.TransactionManager
+	flags           shared_ports.FeatureFlags
 }
 
 func NewCreateUserUseCase(
@@ -39,12 +41,16 @@ func NewCreateUserUseCase(
 	idempotencyRepo shared_ports.IdempotencyRepository,
 	eventBus shared_ports.EventBus,
 	hasher shared_ports.Hasher,
+	txManager shared_ports.TransactionManager,
+	flags shared_ports.FeatureFlags,
 ) *CreateUserUseCase {
 	return &CreateUserUseCase{
 		userRepo:        userRepo,
 		idempotencyRepo: idempotencyRepo,
 		eventBus:        eventBus,
 		hasher:          hasher,
+		txManager:       txManager,
+		flags:           flags,
 	}
 }
 
@@ -81,28 +87,36 @@ func (h *CreateUserUseCase) Execute(ctx context.Context, cmd CreateUserCommand)
 		return nil, err
 	}
 
-	// Crear entidad
-	user, err := entities.NewUser(cmd.TenantID, cmd.Name, email, password, cmd.DisplayName)
+	displayName, err := displayNameIfEnabled(ctx, h.flags, cmd.TenantID, cmd.DisplayName)
 	if err != nil {
 		return nil, err
 	}
 
-	// Persistir
-	if err := h.userRepo.Save(ctx, user); err != nil {
+	// Crear entidad
+	user, err := entities.NewUser(cmd.TenantID, cmd.Name, email, password, displayName)
+	if err != nil {
 		return nil, err
 	}
 
-	// Marcar como procesado
-	if cmd.IdempotencyKey != "" {
-		if err := h.idempotencyRepo.MarkAsProcessed(ctx, cmd.TenantID, cmd.IdempotencyKey); err != nil {
-			return nil, err
+	// Persistir usuario, clave de idempotencia y evento (outbox) en una sola transacción
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.userRepo.Save(ctx, user); err != nil {
+			return err
+		}
+
+		// Marcar como procesado
+		if cmd.IdempotencyKey != "" {
+			if err := h.idempotencyRepo.MarkAsProcessed(ctx, cmd.TenantID, cmd.IdempotencyKey); err != nil {
+				return err
+			}
 		}
-	}
 
-	// Publicar eventos
-	event := events.NewUserCreatedEvent(user)
+		// Publicar eventos
+		event := events.NewUserCreatedEvent(user)
 
-	if err := h.eventBus.Publish(ctx, event, cmd.CorrelationID); err != nil {
+		return h.eventBus.Publish(ctx, event, cmd.CorrelationID)
+	})
+	if err != nil {
 		return nil, err
 	}
 
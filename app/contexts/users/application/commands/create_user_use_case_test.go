@@ -10,6 +10,8 @@ import (
 	user_exceptions "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
 	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/featureflags"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +26,18 @@ func (m *MockUserRepository) Save(ctx context.Context, user *entities.User) erro
 	args := m.Called(ctx, user)
 	return args.Error(0)
 }
+func (m *MockUserRepository) Update(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+func (m *MockUserRepository) SoftDelete(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+func (m *MockUserRepository) Anonymize(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
 func (m *MockUserRepository) FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
 	args := m.Called(ctx, tenantID, id)
 	if v := args.Get(0); v != nil {
@@ -31,6 +45,13 @@ func (m *MockUserRepository) FindByID(ctx context.Context, tenantID string, id u
 	}
 	return nil, args.Error(1)
 }
+func (m *MockUserRepository) FindByIDIncludingDeleted(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
+	args := m.Called(ctx, tenantID, id)
+	if v := args.Get(0); v != nil {
+		return v.(*entities.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
 func (m *MockUserRepository) FindByEmail(ctx context.Context, tenantID string, email value_objects.Email) (*entities.User, error) {
 	args := m.Called(ctx, tenantID, email)
 	if v := args.Get(0); v != nil {
@@ -81,6 +102,8 @@ type CreateUserUseCaseSuite struct {
 	idem   *MockIdempotencyRepository
 	event  *MockEventBus
 	hasher *MockHasher
+	tx     *transaction.InMemoryTransactionManager
+	flags  *featureflags.InMemoryFeatureFlags
 	uc     *commands.CreateUserUseCase
 	ctx    context.Context
 }
@@ -90,7 +113,10 @@ func (s *CreateUserUseCaseSuite) SetupTest() {
 	s.idem = new(MockIdempotencyRepository)
 	s.event = new(MockEventBus)
 	s.hasher = new(MockHasher)
-	s.uc = commands.NewCreateUserUseCase(s.repo, s.idem, s.event, s.hasher)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.flags = featureflags.NewInMemoryFeatureFlags()
+	s.flags.Set(commands.FeatureDisplayName, "", true)
+	s.uc = commands.NewCreateUserUseCase(s.repo, s.idem, s.event, s.hasher, s.tx, s.flags)
 	s.ctx = context.Background()
 }
 
@@ -260,6 +286,7 @@ func (s *CreateUserUseCaseSuite) TestExecute_MarkAsProcessedError() {
 	resp, err := s.uc.Execute(s.ctx, cmd)
 	assert.Nil(s.T(), resp)
 	assert.Error(s.T(), err)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
 	// Publish should not be called if marking as processed fails
 	s.event.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
 }
@@ -278,4 +305,54 @@ func (s *CreateUserUseCaseSuite) TestExecute_PublishError() {
 	resp, err := s.uc.Execute(s.ctx, cmd)
 	assert.Nil(s.T(), resp)
 	assert.Error(s.T(), err)
+	// El usuario y la clave de idempotencia se revierten junto con el evento
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+	assert.Equal(s.T(), 0, s.tx.Commits())
+}
+
+func (s *CreateUserUseCaseSuite) TestExecute_WritesRunInsideTransaction() {
+	cmd := commands.CreateUserCommand{TenantID: "t1", IdempotencyKey: "key-1", CorrelationID: "corr-tx", Email: "john@example.com", Password: "StrongPass1"}
+	inTx := mock.MatchedBy(transaction.InTransaction)
+
+	s.idem.On("IsProcessed", mock.Anything, cmd.TenantID, cmd.IdempotencyKey).Return(false, nil).Once()
+	email, _ := value_objects.NewEmail(cmd.Email)
+	s.repo.On("ExistsByEmail", mock.Anything, cmd.TenantID, email).Return(false, nil).Once()
+	s.hasher.On("Hash", cmd.Password).Return("hashed", nil).Once()
+	s.repo.On("Save", inTx, mock.AnythingOfType("*entities.User")).Return(nil).Once()
+	s.idem.On("MarkAsProcessed", inTx, cmd.TenantID, cmd.IdempotencyKey).Return(nil).Once()
+	s.event.On("Publish", inTx, mock.Anything, cmd.CorrelationID).Return(nil).Once()
+
+	resp, err := s.uc.Execute(s.ctx, cmd)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), resp)
+
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.repo.AssertExpectations(s.T())
+	s.idem.AssertExpectations(s.T())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *CreateUserUseCaseSuite) TestExecute_DisplayNameFlag() {
+	for tenantID, expected := range map[string]bool{"tenant-1": true, "tenant-50": false} {
+		s.Run(tenantID, func() {
+			s.SetupTest()
+			s.flags.Set(commands.FeatureDisplayName, "tenant-50", false)
+			display := "Johnny"
+			cmd := commands.CreateUserCommand{TenantID: tenantID, Name: "John Doe", Email: "john@example.com", Password: "StrongPass1", DisplayName: &display}
+
+			var savedUser *entities.User
+			s.repo.On("ExistsByEmail", mock.Anything, tenantID, mock.Anything).Return(false, nil).Once()
+			s.hasher.On("Hash", cmd.Password).Return("hashed_pwd", nil).Once()
+			s.repo.On("Save", mock.Anything, mock.AnythingOfType("*entities.User")).Return(nil).Run(func(args mock.Arguments) {
+				savedUser = args.Get(1).(*entities.User)
+			}).Once()
+			s.event.On("Publish", mock.Anything, mock.Anything, "").Return(nil).Once()
+
+			_, err := s.uc.Execute(s.ctx, cmd)
+
+			s.Require().NoError(err)
+			// Sin el flag, display_name se ignora sin rechazar el alta
+			s.Equal(expected, savedUser.DisplayName != nil)
+		})
+	}
 }
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/google/uuid"
+)
+
+type DeletionMode string
+
+const (
+	// DeletionModeSoft marca el usuario como borrado y lo oculta de las consultas.
+	DeletionModeSoft DeletionMode = "soft"
+	// DeletionModeErase anonimiza los datos personales (derecho al olvido).
+	DeletionModeErase DeletionMode = "erase"
+)
+
+type DeleteUserCommand struct {
+	TenantID      string
+	UserID        uuid.UUID
+	CorrelationID string
+	Mode          DeletionMode
+}
+
+type DeleteUserUseCase struct {
+	userRepo  ports.UserRepository
+	eventBus  shared_ports.EventBus
+	redactor  shared_ports.EventRedactor
+	txManager shared_ports.TransactionManager
+}
+
+func NewDeleteUserUseCase(
+	userRepo ports.UserRepository,
+	eventBus shared_ports.EventBus,
+	redactor shared_ports.EventRedactor,
+	txManager shared_ports.TransactionManager,
+) *DeleteUserUseCase {
+	return &DeleteUserUseCase{
+		userRepo:  userRepo,
+		eventBus:  eventBus,
+		redactor:  redactor,
+		txManager: txManager,
+	}
+}
+
+func (h *DeleteUserUseCase) Execute(ctx context.Context, cmd DeleteUserCommand) error {
+	switch cmd.Mode {
+	case DeletionModeSoft, "":
+		return h.softDelete(ctx, cmd)
+	case DeletionModeErase:
+		return h.erase(ctx, cmd)
+	default:
+		return exceptions.ErrInvalidDeletionMode
+	}
+}
+
+func (h *DeleteUserUseCase) softDelete(ctx context.Context, cmd DeleteUserCommand) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := h.userRepo.FindByID(ctx, cmd.TenantID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		user.Delete()
+
+		if err := h.userRepo.SoftDelete(ctx, user); err != nil {
+			return err
+		}
+
+		return h.eventBus.Publish(ctx, events.NewUserDeletedEvent(user), cmd.CorrelationID)
+	})
+}
+
+func (h *DeleteUserUseCase) erase(ctx context.Context, cmd DeleteUserCommand) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Un usuario ya borrado en modo soft también puede ser anonimizado
+		user, err := h.userRepo.FindByIDIncludingDeleted(ctx, cmd.TenantID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if err := user.Erase(); err != nil {
+			return err
+		}
+
+		if err := h.userRepo.Anonymize(ctx, user); err != nil {
+			return err
+		}
+
+		// Los user.created y user.updated ya guardados llevan nombre y email
+		if err := h.redactor.Redact(ctx, user.ID.String(), events.ErasedUserFields(user)); err != nil {
+			return err
+		}
+
+		return h.eventBus.Publish(ctx, events.NewUserErasedEvent(user), cmd.CorrelationID)
+	})
+}
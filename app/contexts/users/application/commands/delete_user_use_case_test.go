@@ -0,0 +1,158 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	user_exceptions "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockEventRedactor struct{ mock.Mock }
+
+func (m *MockEventRedactor) Redact(ctx context.Context, aggregateID string, fields map[string]any) error {
+	args := m.Called(ctx, aggregateID, fields)
+	return args.Error(0)
+}
+
+type DeleteUserUseCaseSuite struct {
+	suite.Suite
+	repo     *MockUserRepository
+	event    *MockEventBus
+	redactor *MockEventRedactor
+	tx       *transaction.InMemoryTransactionManager
+	uc       *commands.DeleteUserUseCase
+	ctx      context.Context
+	user     *entities.User
+}
+
+func (s *DeleteUserUseCaseSuite) SetupTest() {
+	s.repo = new(MockUserRepository)
+	s.event = new(MockEventBus)
+	s.redactor = new(MockEventRedactor)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.uc = commands.NewDeleteUserUseCase(s.repo, s.event, s.redactor, s.tx)
+	s.ctx = context.Background()
+
+	email, _ := value_objects.NewEmail("john@example.com")
+	displayName := "Johnny"
+	s.user = &entities.User{
+		ID:          uuid.New(),
+		TenantID:    "tenant-1",
+		Name:        "John Doe",
+		Email:       email,
+		Password:    value_objects.NewPasswordFromHash("hashed"),
+		DisplayName: &displayName,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+func TestDeleteUserUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DeleteUserUseCaseSuite))
+}
+
+func (s *DeleteUserUseCaseSuite) TestExecute_SoftDelete() {
+	cmd := commands.DeleteUserCommand{TenantID: "tenant-1", UserID: s.user.ID, CorrelationID: "corr-1", Mode: commands.DeletionModeSoft}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("SoftDelete", mock.Anything, s.user).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.AnythingOfType("events.UserDeletedEvent"), cmd.CorrelationID).Return(nil).Once()
+
+	err := s.uc.Execute(s.ctx, cmd)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), s.user.IsDeleted())
+	// El borrado lógico conserva los datos
+	assert.Equal(s.T(), "John Doe", s.user.Name)
+	assert.Equal(s.T(), 1, s.tx.Commits())
+	s.repo.AssertNotCalled(s.T(), "Anonymize", mock.Anything, mock.Anything)
+	s.redactor.AssertNotCalled(s.T(), "Redact", mock.Anything, mock.Anything, mock.Anything)
+	s.repo.AssertExpectations(s.T())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *DeleteUserUseCaseSuite) TestExecute_DefaultModeIsSoft() {
+	cmd := commands.DeleteUserCommand{TenantID: "tenant-1", UserID: s.user.ID}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("SoftDelete", mock.Anything, s.user).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.AnythingOfType("events.UserDeletedEvent"), "").Return(nil).Once()
+
+	assert.NoError(s.T(), s.uc.Execute(s.ctx, cmd))
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *DeleteUserUseCaseSuite) TestExecute_Erase() {
+	cmd := commands.DeleteUserCommand{TenantID: "tenant-1", UserID: s.user.ID, CorrelationID: "corr-1", Mode: commands.DeletionModeErase}
+
+	s.repo.On("FindByIDIncludingDeleted", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("Anonymize", mock.Anything, s.user).Return(nil).Once()
+	s.redactor.On("Redact", mock.Anything, s.user.ID.String(), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		fields := args.Get(2).(map[string]any)
+		s.Equal(entities.ErasedUserName, fields["name"])
+		s.Equal(entities.AnonymizedEmail(s.user.ID), fields["email"])
+		s.Contains(fields, "display_name")
+		s.Nil(fields["display_name"])
+	}).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, cmd.CorrelationID).Return(nil).Run(func(args mock.Arguments) {
+		evt := args.Get(1).(events.UserErasedEvent)
+		s.Equal("user.erased", evt.EventType())
+		s.Equal(s.user.ID, evt.Data.ID)
+	}).Once()
+
+	err := s.uc.Execute(s.ctx, cmd)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), entities.ErasedUserName, s.user.Name)
+	assert.Equal(s.T(), entities.AnonymizedEmail(s.user.ID), s.user.Email.Value())
+	assert.Nil(s.T(), s.user.DisplayName)
+	assert.True(s.T(), s.user.IsDeleted())
+	s.repo.AssertExpectations(s.T())
+	s.redactor.AssertExpectations(s.T())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *DeleteUserUseCaseSuite) TestExecute_EraseRollsBackWhenRedactionFails() {
+	cmd := commands.DeleteUserCommand{TenantID: "tenant-1", UserID: s.user.ID, Mode: commands.DeletionModeErase}
+
+	s.repo.On("FindByIDIncludingDeleted", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("Anonymize", mock.Anything, s.user).Return(nil).Once()
+	s.redactor.On("Redact", mock.Anything, s.user.ID.String(), mock.Anything).Return(assert.AnError).Once()
+
+	err := s.uc.Execute(s.ctx, cmd)
+
+	assert.ErrorIs(s.T(), err, assert.AnError)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+	s.event.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *DeleteUserUseCaseSuite) TestExecute_InvalidMode() {
+	cmd := commands.DeleteUserCommand{TenantID: "tenant-1", UserID: s.user.ID, Mode: "purge"}
+
+	err := s.uc.Execute(s.ctx, cmd)
+
+	assert.Equal(s.T(), user_exceptions.ErrInvalidDeletionMode, err)
+	s.repo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *DeleteUserUseCaseSuite) TestExecute_UserNotFound() {
+	cmd := commands.DeleteUserCommand{TenantID: "tenant-1", UserID: uuid.New(), Mode: commands.DeletionModeSoft}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(nil, user_exceptions.ErrUserNotFound).Once()
+
+	err := s.uc.Execute(s.ctx, cmd)
+
+	assert.Equal(s.T(), user_exceptions.ErrUserNotFound, err)
+	s.event.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+}
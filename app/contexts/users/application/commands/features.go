@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"context"
+
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// FeatureDisplayName habilita el campo display_name en alta y edición.
+const FeatureDisplayName = "display_name"
+
+// displayNameIfEnabled descarta display_name si el tenant no tiene el flag,
+// sin rechazar la petición.
+func displayNameIfEnabled(ctx context.Context, flags shared_ports.FeatureFlags, tenantID string, displayName *string) (*string, error) {
+	if displayName == nil {
+		return nil, nil
+	}
+
+	enabled, err := flags.IsEnabled(ctx, FeatureDisplayName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+	return displayName, nil
+}
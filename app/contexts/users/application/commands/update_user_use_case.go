@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/google/uuid"
+)
+
+// UpdateUserCommand usa punteros para distinguir "no modificar" (nil) de un
+// valor nuevo.
+type UpdateUserCommand struct {
+	TenantID      string
+	UserID        uuid.UUID
+	CorrelationID string
+	Name          *string
+	DisplayName   *string
+}
+
+type UpdateUserResponse struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type UpdateUserUseCase struct {
+	userRepo  ports.UserRepository
+	eventBus  shared_ports.EventBus
+	txManager shared_ports.TransactionManager
+	flags     shared_ports.FeatureFlags
+}
+
+func NewUpdateUserUseCase(
+	userRepo ports.UserRepository,
+	eventBus shared_ports.EventBus,
+	txManager shared_ports.TransactionManager,
+	flags shared_ports.FeatureFlags,
+) *UpdateUserUseCase {
+	return &UpdateUserUseCase{
+		userRepo:  userRepo,
+		eventBus:  eventBus,
+		txManager: txManager,
+		flags:     flags,
+	}
+}
+
+func (h *UpdateUserUseCase) Execute(ctx context.Context, cmd UpdateUserCommand) (*UpdateUserResponse, error) {
+	displayName, err := displayNameIfEnabled(ctx, h.flags, cmd.TenantID, cmd.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Name == nil && displayName == nil {
+		return nil, exceptions.ErrEmptyUpdate
+	}
+
+	err = h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := h.userRepo.FindByID(ctx, cmd.TenantID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Aplicar cambios mediante el dominio
+		if cmd.Name != nil {
+			if err := user.Rename(*cmd.Name); err != nil {
+				return err
+			}
+		}
+		if displayName != nil {
+			user.ChangeDisplayName(displayName)
+		}
+
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+
+		// Publicar eventos
+		event := events.NewUserUpdatedEvent(user)
+
+		return h.eventBus.Publish(ctx, event, cmd.CorrelationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateUserResponse{UserID: cmd.UserID}, nil
+}
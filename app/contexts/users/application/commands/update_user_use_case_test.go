@@ -0,0 +1,172 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	user_exceptions "github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/featureflags"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateUserUseCaseSuite struct {
+	suite.Suite
+	repo  *MockUserRepository
+	event *MockEventBus
+	tx    *transaction.InMemoryTransactionManager
+	flags *featureflags.InMemoryFeatureFlags
+	uc    *commands.UpdateUserUseCase
+	ctx   context.Context
+	user  *entities.User
+}
+
+func (s *UpdateUserUseCaseSuite) SetupTest() {
+	s.repo = new(MockUserRepository)
+	s.event = new(MockEventBus)
+	s.tx = transaction.NewInMemoryTransactionManager()
+	s.flags = featureflags.NewInMemoryFeatureFlags()
+	s.flags.Set(commands.FeatureDisplayName, "", true)
+	s.uc = commands.NewUpdateUserUseCase(s.repo, s.event, s.tx, s.flags)
+	s.ctx = context.Background()
+
+	email, _ := value_objects.NewEmail("john@example.com")
+	createdAt := time.Now().Add(-time.Hour)
+	s.user = &entities.User{
+		ID:        uuid.New(),
+		TenantID:  "tenant-1",
+		Name:      "John Doe",
+		Email:     email,
+		Password:  value_objects.NewPasswordFromHash("hashed"),
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+func TestUpdateUserUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UpdateUserUseCaseSuite))
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_Success() {
+	name := "Johnny Doe"
+	display := "JD"
+	previousUpdatedAt := s.user.UpdatedAt
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: s.user.ID, CorrelationID: "corr-1", Name: &name, DisplayName: &display}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("Update", mock.Anything, s.user).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, cmd.CorrelationID).Return(nil).Run(func(args mock.Arguments) {
+		evt := args.Get(1).(events.UserUpdatedEvent)
+		s.Equal("user.updated", evt.EventType())
+		s.Equal(s.user.ID.String(), evt.AggregateID())
+		s.Equal(name, evt.Data.Name)
+		s.Equal(&display, evt.Data.DisplayName)
+	}).Once()
+
+	resp, err := s.uc.Execute(s.ctx, cmd)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), s.user.ID, resp.UserID)
+	assert.Equal(s.T(), name, s.user.Name)
+	assert.True(s.T(), s.user.UpdatedAt.After(previousUpdatedAt))
+	assert.Equal(s.T(), 1, s.tx.Commits())
+
+	s.repo.AssertExpectations(s.T())
+	s.event.AssertExpectations(s.T())
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_OnlyDisplayNameKeepsName() {
+	display := "JD"
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: s.user.ID, DisplayName: &display}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("Update", mock.Anything, s.user).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, "").Return(nil).Once()
+
+	_, err := s.uc.Execute(s.ctx, cmd)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "John Doe", s.user.Name)
+	assert.Equal(s.T(), &display, s.user.DisplayName)
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_DisplayNameFlagDisabled() {
+	s.flags.Set(commands.FeatureDisplayName, "tenant-1", false)
+	name := "Johnny Doe"
+	display := "JD"
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: s.user.ID, Name: &name, DisplayName: &display}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("Update", mock.Anything, s.user).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, "").Return(nil).Once()
+
+	_, err := s.uc.Execute(s.ctx, cmd)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), name, s.user.Name)
+	assert.Nil(s.T(), s.user.DisplayName)
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_OnlyDisplayNameWithFlagDisabled() {
+	s.flags.Set(commands.FeatureDisplayName, "tenant-1", false)
+	display := "JD"
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: s.user.ID, DisplayName: &display}
+
+	_, err := s.uc.Execute(s.ctx, cmd)
+	assert.Equal(s.T(), user_exceptions.ErrEmptyUpdate, err)
+	s.repo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_NothingToUpdate() {
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: s.user.ID}
+
+	resp, err := s.uc.Execute(s.ctx, cmd)
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), user_exceptions.ErrEmptyUpdate, err)
+	s.repo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_UserNotFound() {
+	name := "Johnny"
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: uuid.New(), Name: &name}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(nil, user_exceptions.ErrUserNotFound).Once()
+
+	resp, err := s.uc.Execute(s.ctx, cmd)
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), user_exceptions.ErrUserNotFound, err)
+	s.event.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_BlankNameRejected() {
+	name := "   "
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: s.user.ID, Name: &name}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+
+	resp, err := s.uc.Execute(s.ctx, cmd)
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), user_exceptions.ErrInvalidName, err)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+}
+
+func (s *UpdateUserUseCaseSuite) TestExecute_PublishErrorRollsBack() {
+	name := "Johnny"
+	cmd := commands.UpdateUserCommand{TenantID: "tenant-1", UserID: s.user.ID, Name: &name}
+
+	s.repo.On("FindByID", mock.Anything, cmd.TenantID, cmd.UserID).Return(s.user, nil).Once()
+	s.repo.On("Update", mock.Anything, s.user).Return(nil).Once()
+	s.event.On("Publish", mock.Anything, mock.Anything, "").Return(errors.New("outbox down")).Once()
+
+	resp, err := s.uc.Execute(s.ctx, cmd)
+	assert.Nil(s.T(), resp)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), 1, s.tx.Rollbacks())
+}
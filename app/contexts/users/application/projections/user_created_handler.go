@@ -3,6 +3,7 @@ package projections
 import (
 	"context"
 
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
 	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
@@ -23,7 +24,17 @@ func (uc *UserCreatedHandler) Handle(ctx context.Context, event *events.UserCrea
 		return shared_exceptions.NewInternalServerError("failed to create user read model", "event cannot be nil")
 	}
 
-	if err := uc.userReadRepo.Upsert(ctx, &event.Data); err != nil {
+	user := entities.NewUserRead(
+		event.Data.ID,
+		event.Data.TenantID,
+		event.Data.Name,
+		event.Data.Email,
+		event.Data.DisplayName,
+		event.Data.CreatedAt,
+		event.Data.CreatedAt,
+	)
+
+	if err := uc.userReadRepo.Upsert(ctx, user); err != nil {
 		return shared_exceptions.NewInternalServerError("failed to create user read model", err.Error())
 	}
 	return nil
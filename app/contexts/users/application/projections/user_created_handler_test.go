@@ -9,6 +9,7 @@ import (
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/projections"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
 	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -31,24 +32,51 @@ func (m *MockUserReadRepository) Upsert(ctx context.Context, dto *entities.UserR
 	return args.Error(0)
 }
 
+func (m *MockUserReadRepository) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+func (m *MockUserReadRepository) Anonymize(ctx context.Context, tenantID string, id uuid.UUID, name, email string) error {
+	args := m.Called(ctx, tenantID, id, name, email)
+	return args.Error(0)
+}
+
+func (m *MockUserReadRepository) List(ctx context.Context, criteria ports.UserReadListCriteria) ([]*entities.UserRead, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.UserRead), args.Error(1)
+}
+
 type UserCreatedHandlerSuite struct {
 	suite.Suite
 	repo      *MockUserReadRepository
 	handler   *projections.UserCreatedHandler
 	ctx       context.Context
 	eventData *events.UserCreatedEvent
+	expected  *entities.UserRead
 }
 
 func (s *UserCreatedHandlerSuite) SetupTest() {
 	s.repo = new(MockUserReadRepository)
 	s.handler = projections.NewUserCreatedHandler(s.repo)
 	s.ctx = context.Background()
-	user := entities.NewUserRead(uuid.New(), "tenant-1", "John Doe", "john@example.com", nil, time.Now().Format(time.RFC3339))
+	createdAt := time.Now()
+	s.expected = entities.NewUserRead(uuid.New(), "tenant-1", "John Doe", "john@example.com", nil, createdAt, createdAt)
 	s.eventData = &events.UserCreatedEvent{
 		BaseEvent: shared_events.NewBaseEvent(
-			"user.created", user.ID.String(),
+			events.UserCreatedEventType, events.UserCreatedEventVersion, s.expected.ID.String(),
 		),
-		Data: *user,
+		Data: events.UserCreatedData{
+			ID:        s.expected.ID,
+			TenantID:  s.expected.TenantID,
+			Name:      s.expected.Name,
+			Email:     s.expected.Email,
+			Role:      "member",
+			CreatedAt: s.expected.CreatedAt,
+		},
 	}
 }
 
@@ -58,7 +86,7 @@ func TestUserCreatedHandlerSuite(t *testing.T) {
 
 func (s *UserCreatedHandlerSuite) TestExecute_Success() {
 	// Arrange
-	s.repo.On("Upsert", mock.Anything, &s.eventData.Data).Return(nil).Once()
+	s.repo.On("Upsert", mock.Anything, s.expected).Return(nil).Once()
 
 	// Act
 	err := s.handler.Handle(s.ctx, s.eventData)
@@ -70,7 +98,7 @@ func (s *UserCreatedHandlerSuite) TestExecute_Success() {
 
 func (s *UserCreatedHandlerSuite) TestExecute_RepoErrorWrapped() {
 	// Arrange
-	s.repo.On("Upsert", mock.Anything, &s.eventData.Data).Return(errors.New("db error")).Once()
+	s.repo.On("Upsert", mock.Anything, s.expected).Return(errors.New("db error")).Once()
 
 	// Act
 	err := s.handler.Handle(s.ctx, s.eventData)
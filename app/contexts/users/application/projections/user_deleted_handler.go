@@ -0,0 +1,30 @@
+package projections
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+)
+
+type UserDeletedHandler struct {
+	userReadRepo ports.UserReadRepository
+}
+
+func NewUserDeletedHandler(userReadRepo ports.UserReadRepository) *UserDeletedHandler {
+	return &UserDeletedHandler{
+		userReadRepo: userReadRepo,
+	}
+}
+
+func (uc *UserDeletedHandler) Handle(ctx context.Context, event *events.UserDeletedEvent) error {
+	if event == nil {
+		return shared_exceptions.NewInternalServerError("failed to delete user read model", "event cannot be nil")
+	}
+
+	if err := uc.userReadRepo.Delete(ctx, event.Data.TenantID, event.Data.ID); err != nil {
+		return shared_exceptions.NewInternalServerError("failed to delete user read model", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package projections_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/projections"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserDeletedHandler_DeletesReadModel(t *testing.T) {
+	// Arrange
+	repo := new(MockUserReadRepository)
+	handler := projections.NewUserDeletedHandler(repo)
+	id := uuid.New()
+	event := &events.UserDeletedEvent{
+		BaseEvent: shared_events.NewBaseEvent(events.UserDeletedEventType, events.UserDeletedEventVersion, id.String()),
+		Data:      events.UserDeletedData{ID: id, TenantID: "tenant-1"},
+	}
+	repo.On("Delete", mock.Anything, "tenant-1", id).Return(nil).Once()
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUserDeletedHandler_RepoErrorWrapped(t *testing.T) {
+	// Arrange
+	repo := new(MockUserReadRepository)
+	handler := projections.NewUserDeletedHandler(repo)
+	id := uuid.New()
+	event := &events.UserDeletedEvent{Data: events.UserDeletedData{ID: id, TenantID: "tenant-1"}}
+	repo.On("Delete", mock.Anything, "tenant-1", id).Return(errors.New("db error")).Once()
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.ErrorContains(t, err, "failed to delete user read model")
+}
+
+func TestUserErasedHandler_AnonymizesReadModel(t *testing.T) {
+	// Arrange
+	repo := new(MockUserReadRepository)
+	handler := projections.NewUserErasedHandler(repo)
+	id := uuid.New()
+	event := &events.UserErasedEvent{
+		BaseEvent: shared_events.NewBaseEvent(events.UserErasedEventType, events.UserErasedEventVersion, id.String()),
+		Data:      events.UserErasedData{ID: id, TenantID: "tenant-1"},
+	}
+	repo.On("Anonymize", mock.Anything, "tenant-1", id, entities.ErasedUserName, entities.AnonymizedEmail(id)).Return(nil).Once()
+
+	// Act
+	err := handler.Handle(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUserErasedHandler_NilEvent_ReturnsError(t *testing.T) {
+	handler := projections.NewUserErasedHandler(new(MockUserReadRepository))
+
+	err := handler.Handle(context.Background(), nil)
+
+	assert.ErrorContains(t, err, "failed to erase user read model")
+}
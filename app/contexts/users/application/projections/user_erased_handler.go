@@ -0,0 +1,38 @@
+package projections
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+)
+
+type UserErasedHandler struct {
+	userReadRepo ports.UserReadRepository
+}
+
+func NewUserErasedHandler(userReadRepo ports.UserReadRepository) *UserErasedHandler {
+	return &UserErasedHandler{
+		userReadRepo: userReadRepo,
+	}
+}
+
+func (uc *UserErasedHandler) Handle(ctx context.Context, event *events.UserErasedEvent) error {
+	if event == nil {
+		return shared_exceptions.NewInternalServerError("failed to erase user read model", "event cannot be nil")
+	}
+
+	err := uc.userReadRepo.Anonymize(
+		ctx,
+		event.Data.TenantID,
+		event.Data.ID,
+		entities.ErasedUserName,
+		entities.AnonymizedEmail(event.Data.ID),
+	)
+	if err != nil {
+		return shared_exceptions.NewInternalServerError("failed to erase user read model", err.Error())
+	}
+	return nil
+}
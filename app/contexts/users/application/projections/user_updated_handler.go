@@ -0,0 +1,46 @@
+package projections
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+)
+
+type UserUpdatedHandler struct {
+	userReadRepo ports.UserReadRepository
+}
+
+func NewUserUpdatedHandler(userReadRepo ports.UserReadRepository) *UserUpdatedHandler {
+	return &UserUpdatedHandler{
+		userReadRepo: userReadRepo,
+	}
+}
+
+func (uc *UserUpdatedHandler) Handle(ctx context.Context, event *events.UserUpdatedEvent) error {
+	if event == nil {
+		return shared_exceptions.NewInternalServerError("failed to update user read model", "event cannot be nil")
+	}
+
+	user, err := uc.userReadRepo.FindByID(ctx, event.Data.TenantID, event.Data.ID)
+	if err != nil {
+		// Si la proyección de user.created aún no existe, se reintenta más tarde
+		return shared_exceptions.NewInternalServerError("failed to update user read model", err.Error())
+	}
+
+	// Un evento que vuelve de una cola de reintento puede ser anterior al
+	// estado ya proyectado
+	if !event.Data.UpdatedAt.After(user.UpdatedAt) {
+		return nil
+	}
+
+	user.Name = event.Data.Name
+	user.DisplayName = event.Data.DisplayName
+	user.UpdatedAt = event.Data.UpdatedAt
+
+	if err := uc.userReadRepo.Upsert(ctx, user); err != nil {
+		return shared_exceptions.NewInternalServerError("failed to update user read model", err.Error())
+	}
+	return nil
+}
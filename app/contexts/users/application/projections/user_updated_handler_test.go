@@ -0,0 +1,100 @@
+package projections_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/projections"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserUpdatedHandlerSuite struct {
+	suite.Suite
+	repo     *MockUserReadRepository
+	handler  *projections.UserUpdatedHandler
+	ctx      context.Context
+	existing *entities.UserRead
+	event    *events.UserUpdatedEvent
+}
+
+func (s *UserUpdatedHandlerSuite) SetupTest() {
+	s.repo = new(MockUserReadRepository)
+	s.handler = projections.NewUserUpdatedHandler(s.repo)
+	s.ctx = context.Background()
+	s.existing = entities.NewUserRead(uuid.New(), "tenant-1", "John Doe", "john@example.com", nil, time.Now(), time.Now())
+
+	display := "JD"
+	s.event = &events.UserUpdatedEvent{
+		BaseEvent: shared_events.NewBaseEvent(events.UserUpdatedEventType, events.UserUpdatedEventVersion, s.existing.ID.String()),
+		Data: events.UserUpdatedData{
+			ID:          s.existing.ID,
+			TenantID:    s.existing.TenantID,
+			Name:        "Johnny Doe",
+			DisplayName: &display,
+			UpdatedAt:   time.Now(),
+		},
+	}
+}
+
+func TestUserUpdatedHandlerSuite(t *testing.T) {
+	suite.Run(t, new(UserUpdatedHandlerSuite))
+}
+
+func (s *UserUpdatedHandlerSuite) TestHandle_AppliesChanges() {
+	// Arrange
+	s.repo.On("FindByID", mock.Anything, "tenant-1", s.existing.ID).Return(s.existing, nil).Once()
+	s.repo.On("Upsert", mock.Anything, mock.MatchedBy(func(u *entities.UserRead) bool {
+		return u.Name == "Johnny Doe" && *u.DisplayName == "JD" && u.Email == "john@example.com" &&
+			u.UpdatedAt.Equal(s.event.Data.UpdatedAt)
+	})).Return(nil).Once()
+
+	// Act
+	err := s.handler.Handle(s.ctx, s.event)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *UserUpdatedHandlerSuite) TestHandle_OlderEvent_IsIgnored() {
+	// Arrange: la proyección ya refleja una actualización posterior
+	newer := *s.existing
+	newer.UpdatedAt = s.event.Data.UpdatedAt.Add(time.Second)
+	s.repo.On("FindByID", mock.Anything, "tenant-1", s.existing.ID).Return(&newer, nil).Once()
+
+	// Act
+	err := s.handler.Handle(s.ctx, s.event)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.repo.AssertNotCalled(s.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (s *UserUpdatedHandlerSuite) TestHandle_ReadModelMissing_ReturnsError() {
+	// Arrange
+	s.repo.On("FindByID", mock.Anything, "tenant-1", s.existing.ID).Return(nil, errors.New("not found")).Once()
+
+	// Act
+	err := s.handler.Handle(s.ctx, s.event)
+
+	// Assert
+	assert.Error(s.T(), err)
+	s.repo.AssertNotCalled(s.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (s *UserUpdatedHandlerSuite) TestHandle_NilEvent_ReturnsError() {
+	// Act
+	err := s.handler.Handle(s.ctx, nil)
+
+	// Assert
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to update user read model")
+}
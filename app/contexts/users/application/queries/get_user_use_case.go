@@ -6,12 +6,14 @@ import (
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	"github.com/google/uuid"
 )
 
 type GetUserQuery struct {
 	TenantID string
 	UserID   uuid.UUID
+	Actor    authorization.Principal
 }
 
 type GetUserUseCase struct {
@@ -23,6 +25,10 @@ func NewGetUserUseCase(readModel ports.UserReadRepository) *GetUserUseCase {
 }
 
 func (h *GetUserUseCase) Execute(ctx context.Context, query GetUserQuery) (*entities.UserRead, error) {
+	if !canReadUser(query.Actor, query.TenantID, query.UserID) {
+		return nil, exceptions.ErrUserAccessDenied
+	}
+
 	user, err := h.readRepo.FindByID(ctx, query.TenantID, query.UserID)
 	if err != nil {
 		return nil, exceptions.ErrUserNotFound
@@ -9,6 +9,8 @@ import (
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/queries"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -32,6 +34,28 @@ func (m *MockUserReadRepository) Upsert(ctx context.Context, dto *entities.UserR
 	return args.Error(0)
 }
 
+func (m *MockUserReadRepository) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+func (m *MockUserReadRepository) Anonymize(ctx context.Context, tenantID string, id uuid.UUID, name, email string) error {
+	args := m.Called(ctx, tenantID, id, name, email)
+	return args.Error(0)
+}
+
+func (m *MockUserReadRepository) List(ctx context.Context, criteria ports.UserReadListCriteria) ([]*entities.UserRead, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.UserRead), args.Error(1)
+}
+
+func adminActor(tenantID string) authorization.Principal {
+	return authorization.Principal{UserID: uuid.NewString(), TenantID: tenantID, Role: authorization.RoleTenantAdmin}
+}
+
 // Test Suite
 
 type GetUserUseCaseSuite struct {
@@ -62,12 +86,14 @@ func (s *GetUserUseCaseSuite) TestExecute_Success() {
 		"John Doe",
 		"john.doe@example.com",
 		&displayName,
-		time.Now().Format(time.RFC3339),
+		time.Now(),
+		time.Now(),
 	)
 
 	query := queries.GetUserQuery{
 		TenantID: tenantID,
 		UserID:   userID,
+		Actor:    adminActor(tenantID),
 	}
 
 	s.repo.On("FindByID", mock.Anything, tenantID, userID).Return(expectedUser, nil).Once()
@@ -94,6 +120,7 @@ func (s *GetUserUseCaseSuite) TestExecute_UserNotFound() {
 	query := queries.GetUserQuery{
 		TenantID: tenantID,
 		UserID:   userID,
+		Actor:    adminActor(tenantID),
 	}
 
 	s.repo.On("FindByID", mock.Anything, tenantID, userID).Return(nil, errors.New("not found")).Once()
@@ -116,6 +143,7 @@ func (s *GetUserUseCaseSuite) TestExecute_RepositoryError() {
 	query := queries.GetUserQuery{
 		TenantID: tenantID,
 		UserID:   userID,
+		Actor:    adminActor(tenantID),
 	}
 
 	s.repo.On("FindByID", mock.Anything, tenantID, userID).Return(nil, errors.New("db connection error")).Once()
@@ -140,12 +168,14 @@ func (s *GetUserUseCaseSuite) TestExecute_WithoutDisplayName() {
 		"Jane Doe",
 		"jane.doe@example.com",
 		nil,
-		time.Now().Format(time.RFC3339),
+		time.Now(),
+		time.Now(),
 	)
 
 	query := queries.GetUserQuery{
 		TenantID: tenantID,
 		UserID:   userID,
+		Actor:    adminActor(tenantID),
 	}
 
 	s.repo.On("FindByID", mock.Anything, tenantID, userID).Return(expectedUser, nil).Once()
@@ -168,15 +198,15 @@ func (s *GetUserUseCaseSuite) TestExecute_DifferentTenants() {
 	tenant1 := "tenant-1"
 	tenant2 := "tenant-2"
 
-	user1 := entities.NewUserRead(userID1, tenant1, "User 1", "user1@example.com", nil, time.Now().Format(time.RFC3339))
-	user2 := entities.NewUserRead(userID2, tenant2, "User 2", "user2@example.com", nil, time.Now().Format(time.RFC3339))
+	user1 := entities.NewUserRead(userID1, tenant1, "User 1", "user1@example.com", nil, time.Now(), time.Now())
+	user2 := entities.NewUserRead(userID2, tenant2, "User 2", "user2@example.com", nil, time.Now(), time.Now())
 
 	s.repo.On("FindByID", mock.Anything, tenant1, userID1).Return(user1, nil).Once()
 	s.repo.On("FindByID", mock.Anything, tenant2, userID2).Return(user2, nil).Once()
 
 	// Act
-	result1, err1 := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: tenant1, UserID: userID1})
-	result2, err2 := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: tenant2, UserID: userID2})
+	result1, err1 := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: tenant1, UserID: userID1, Actor: adminActor(tenant1)})
+	result2, err2 := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: tenant2, UserID: userID2, Actor: adminActor(tenant2)})
 
 	// Assert
 	assert.NoError(s.T(), err1)
@@ -186,3 +216,54 @@ func (s *GetUserUseCaseSuite) TestExecute_DifferentTenants() {
 	assert.NotEqual(s.T(), result1.ID, result2.ID)
 	s.repo.AssertExpectations(s.T())
 }
+
+func (s *GetUserUseCaseSuite) TestExecute_MemberReadsOwnProfile() {
+	// Arrange
+	userID := uuid.New()
+	tenantID := "tenant-123"
+	expectedUser := entities.NewUserRead(userID, tenantID, "John Doe", "john.doe@example.com", nil, time.Now(), time.Now())
+	actor := authorization.Principal{UserID: userID.String(), TenantID: tenantID, Role: authorization.RoleMember}
+
+	s.repo.On("FindByID", mock.Anything, tenantID, userID).Return(expectedUser, nil).Once()
+
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: tenantID, UserID: userID, Actor: actor})
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), userID, result.ID)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *GetUserUseCaseSuite) TestExecute_MemberCannotReadOthers() {
+	// Arrange
+	tenantID := "tenant-123"
+	actor := authorization.Principal{UserID: uuid.NewString(), TenantID: tenantID, Role: authorization.RoleMember}
+
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: tenantID, UserID: uuid.New(), Actor: actor})
+
+	// Assert
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), exceptions.ErrUserAccessDenied, err)
+	s.repo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *GetUserUseCaseSuite) TestExecute_AdminOfAnotherTenant() {
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: "tenant-1", UserID: uuid.New(), Actor: adminActor("tenant-2")})
+
+	// Assert
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), exceptions.ErrUserAccessDenied, err)
+	s.repo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *GetUserUseCaseSuite) TestExecute_AnonymousActor() {
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.GetUserQuery{TenantID: "tenant-1", UserID: uuid.New()})
+
+	// Assert
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), exceptions.ErrUserAccessDenied, err)
+}
@@ -0,0 +1,94 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+)
+
+const (
+	DefaultListUsersLimit = 20
+	MaxListUsersLimit     = 100
+)
+
+type ListUsersQuery struct {
+	TenantID     string
+	Cursor       string
+	Limit        int
+	EmailPrefix  string
+	NameContains string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	Order        string
+}
+
+type ListUsersResponse struct {
+	Items      []*entities.UserRead `json:"items"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+}
+
+type ListUsersUseCase struct {
+	readRepo ports.UserReadRepository
+}
+
+func NewListUsersUseCase(readModel ports.UserReadRepository) *ListUsersUseCase {
+	return &ListUsersUseCase{readRepo: readModel}
+}
+
+func (h *ListUsersUseCase) Execute(ctx context.Context, query ListUsersQuery) (*ListUsersResponse, error) {
+	order := ports.SortDesc
+	switch query.Order {
+	case "", string(ports.SortDesc):
+	case string(ports.SortAsc):
+		order = ports.SortAsc
+	default:
+		return nil, exceptions.ErrInvalidSortOrder
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultListUsersLimit
+	}
+	if limit > MaxListUsersLimit {
+		limit = MaxListUsersLimit
+	}
+
+	if query.CreatedFrom != nil && query.CreatedTo != nil && query.CreatedFrom.After(*query.CreatedTo) {
+		return nil, exceptions.ErrInvalidDateRange
+	}
+
+	criteria := ports.UserReadListCriteria{
+		TenantID:     query.TenantID,
+		EmailPrefix:  query.EmailPrefix,
+		NameContains: query.NameContains,
+		CreatedFrom:  query.CreatedFrom,
+		CreatedTo:    query.CreatedTo,
+		Order:        order,
+		// Se pide un elemento extra para saber si existe otra página
+		Limit: limit + 1,
+	}
+
+	if query.Cursor != "" {
+		after, err := decodeUserCursor(query.Cursor, order)
+		if err != nil {
+			return nil, err
+		}
+		criteria.After = after
+	}
+
+	users, err := h.readRepo.List(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUsersResponse{Items: users}
+	if len(users) > limit {
+		response.Items = users[:limit]
+		response.NextCursor = encodeUserCursor(users[limit-1], order)
+	}
+
+	return response, nil
+}
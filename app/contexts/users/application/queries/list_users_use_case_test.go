@@ -0,0 +1,166 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/queries"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListUsersUseCaseSuite struct {
+	suite.Suite
+	repo *MockUserReadRepository
+	uc   *queries.ListUsersUseCase
+	ctx  context.Context
+}
+
+func (s *ListUsersUseCaseSuite) SetupTest() {
+	s.repo = new(MockUserReadRepository)
+	s.uc = queries.NewListUsersUseCase(s.repo)
+	s.ctx = context.Background()
+}
+
+func TestListUsersUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ListUsersUseCaseSuite))
+}
+
+func readUsers(tenantID string, n int) []*entities.UserRead {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := make([]*entities.UserRead, 0, n)
+	for i := 0; i < n; i++ {
+		users = append(users, entities.NewUserRead(
+			uuid.New(),
+			tenantID,
+			"User",
+			"user@example.com",
+			nil,
+			base.Add(time.Duration(i)*time.Minute),
+			base.Add(time.Duration(i)*time.Minute),
+		))
+	}
+	return users
+}
+
+func (s *ListUsersUseCaseSuite) TestExecute_DefaultsAndLastPage() {
+	// Arrange
+	tenantID := "tenant-123"
+	users := readUsers(tenantID, 3)
+
+	s.repo.On("List", mock.Anything, mock.MatchedBy(func(c ports.UserReadListCriteria) bool {
+		return c.TenantID == tenantID &&
+			c.Order == ports.SortDesc &&
+			c.Limit == queries.DefaultListUsersLimit+1 &&
+			c.After == nil
+	})).Return(users, nil).Once()
+
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: tenantID})
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), result.Items, 3)
+	assert.Empty(s.T(), result.NextCursor)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *ListUsersUseCaseSuite) TestExecute_ClampsLimit() {
+	// Arrange
+	tenantID := "tenant-123"
+
+	s.repo.On("List", mock.Anything, mock.MatchedBy(func(c ports.UserReadListCriteria) bool {
+		return c.Limit == queries.MaxListUsersLimit+1
+	})).Return([]*entities.UserRead{}, nil).Once()
+
+	// Act
+	_, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: tenantID, Limit: 1000})
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *ListUsersUseCaseSuite) TestExecute_NextCursorRoundTrip() {
+	// Arrange
+	tenantID := "tenant-123"
+	users := readUsers(tenantID, 3)
+	last := users[1]
+
+	s.repo.On("List", mock.Anything, mock.MatchedBy(func(c ports.UserReadListCriteria) bool {
+		return c.After == nil && c.Order == ports.SortAsc
+	})).Return(users, nil).Once()
+
+	s.repo.On("List", mock.Anything, mock.MatchedBy(func(c ports.UserReadListCriteria) bool {
+		return c.After != nil && c.After.ID == last.ID && c.After.CreatedAt.Equal(last.CreatedAt)
+	})).Return(users[2:], nil).Once()
+
+	// Act
+	first, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: tenantID, Limit: 2, Order: "asc"})
+	assert.NoError(s.T(), err)
+
+	second, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: tenantID, Limit: 2, Order: "asc", Cursor: first.NextCursor})
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), first.Items, 2)
+	assert.NotEmpty(s.T(), first.NextCursor)
+	assert.Len(s.T(), second.Items, 1)
+	assert.Empty(s.T(), second.NextCursor)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *ListUsersUseCaseSuite) TestExecute_CursorWithDifferentOrder() {
+	// Arrange
+	tenantID := "tenant-123"
+	s.repo.On("List", mock.Anything, mock.Anything).Return(readUsers(tenantID, 2), nil).Once()
+
+	first, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: tenantID, Limit: 1, Order: "asc"})
+	assert.NoError(s.T(), err)
+
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: tenantID, Limit: 1, Order: "desc", Cursor: first.NextCursor})
+
+	// Assert
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), exceptions.ErrInvalidCursor, err)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *ListUsersUseCaseSuite) TestExecute_InvalidCursor() {
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: "tenant-123", Cursor: "not-a-cursor"})
+
+	// Assert
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), exceptions.ErrInvalidCursor, err)
+	s.repo.AssertNotCalled(s.T(), "List", mock.Anything, mock.Anything)
+}
+
+func (s *ListUsersUseCaseSuite) TestExecute_InvalidOrder() {
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: "tenant-123", Order: "sideways"})
+
+	// Assert
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), exceptions.ErrInvalidSortOrder, err)
+}
+
+func (s *ListUsersUseCaseSuite) TestExecute_InvalidDateRange() {
+	// Arrange
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	// Act
+	result, err := s.uc.Execute(s.ctx, queries.ListUsersQuery{TenantID: "tenant-123", CreatedFrom: &from, CreatedTo: &to})
+
+	// Assert
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), exceptions.ErrInvalidDateRange, err)
+}
@@ -0,0 +1,43 @@
+package queries
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/google/uuid"
+)
+
+// userCursor es el contenido del cursor opaco que recibe el cliente. Incluye el
+// orden para rechazar cursores reutilizados con un orden distinto.
+type userCursor struct {
+	CreatedAt time.Time       `json:"c"`
+	ID        uuid.UUID       `json:"i"`
+	Order     ports.SortOrder `json:"o"`
+}
+
+func encodeUserCursor(user *entities.UserRead, order ports.SortOrder) string {
+	data, _ := json.Marshal(userCursor{CreatedAt: user.CreatedAt, ID: user.ID, Order: order})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeUserCursor(value string, order ports.SortOrder) (*ports.UserReadCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, exceptions.ErrInvalidCursor
+	}
+
+	var cursor userCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, exceptions.ErrInvalidCursor
+	}
+
+	if cursor.Order != order || cursor.ID == uuid.Nil {
+		return nil, exceptions.ErrInvalidCursor
+	}
+
+	return &ports.UserReadCursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID}, nil
+}
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	"github.com/google/uuid"
+)
+
+// canReadUser aplica la política de lectura: un administrador lee cualquier
+// usuario de su tenant y un miembro solo su propio perfil. Se evalúa antes de
+// consultar el repositorio para no revelar qué usuarios existen.
+func canReadUser(actor authorization.Principal, tenantID string, userID uuid.UUID) bool {
+	if actor.TenantID != tenantID {
+		return false
+	}
+
+	if actor.Can(authorization.PermissionUsersReadAny) {
+		return true
+	}
+
+	return actor.Can(authorization.PermissionUsersRead) && actor.UserID == userID.String()
+}
@@ -1,9 +1,13 @@
 package entities
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	"github.com/google/uuid"
 )
 
@@ -14,11 +18,27 @@ type User struct {
 	Email       value_objects.Email    `json:"email"`
 	Password    value_objects.Password `json:"-"`
 	DisplayName *string                `json:"display_name,omitempty"`
+	Role        authorization.Role     `json:"role"`
 	CreatedAt   time.Time              `json:"created_at"`
 	UpdatedAt   time.Time              `json:"updated_at"`
+	DeletedAt   *time.Time             `json:"deleted_at,omitempty"`
+
+	// Version es la posición del agregado en su stream cuando se persiste como
+	// eventos; el repositorio la usa para la concurrencia optimista.
+	Version int `json:"-"`
+}
+
+// ErasedUserName reemplaza el nombre de un usuario borrado por derecho al olvido.
+const ErasedUserName = "Erased User"
+
+// AnonymizedEmail genera un email no identificable pero único por usuario, de
+// modo que la restricción de email único por tenant se sigue cumpliendo.
+func AnonymizedEmail(id uuid.UUID) string {
+	return fmt.Sprintf("erased-%s@erased.invalid", id)
 }
 
 func NewUser(tenantID, name string, email value_objects.Email, password value_objects.Password, displayName *string) (*User, error) {
+	now := time.Now()
 	user := &User{
 		ID:          uuid.New(),
 		TenantID:    tenantID,
@@ -26,9 +46,70 @@ func NewUser(tenantID, name string, email value_objects.Email, password value_ob
 		Email:       email,
 		Password:    password,
 		DisplayName: displayName,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		Role:        authorization.RoleMember,
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 
 	return user, nil
 }
+
+func (u *User) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return exceptions.ErrInvalidName
+	}
+
+	u.Name = name
+	u.touch()
+	return nil
+}
+
+func (u *User) ChangeDisplayName(displayName *string) {
+	u.DisplayName = displayName
+	u.touch()
+}
+
+// PromoteToAdmin asigna uno de los roles de administración. El resto de
+// usuarios se crean como member y no cambian de rol desde la API.
+func (u *User) PromoteToAdmin(role authorization.Role) error {
+	if role != authorization.RolePlatformAdmin && role != authorization.RoleTenantAdmin {
+		return exceptions.ErrInvalidAdminRole
+	}
+
+	u.Role = role
+	u.touch()
+	return nil
+}
+
+func (u *User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
+func (u *User) Delete() {
+	now := time.Now()
+	u.DeletedAt = &now
+	u.UpdatedAt = now
+}
+
+// Erase anonimiza los datos personales del usuario y lo marca como borrado.
+func (u *User) Erase() error {
+	email, err := value_objects.NewEmail(AnonymizedEmail(u.ID))
+	if err != nil {
+		return err
+	}
+
+	u.Name = ErasedUserName
+	u.Email = email
+	u.DisplayName = nil
+	u.Password = value_objects.NewPasswordFromHash("")
+	if !u.IsDeleted() {
+		u.Delete()
+	}
+	u.touch()
+	return nil
+}
+
+func (u *User) touch() {
+	u.UpdatedAt = time.Now()
+}
@@ -1,6 +1,10 @@
 package entities
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type UserRead struct {
 	ID          uuid.UUID `json:"id"`
@@ -8,10 +12,11 @@ type UserRead struct {
 	Name        string    `json:"name"`
 	Email       string    `json:"email"`
 	DisplayName *string   `json:"display_name,omitempty"`
-	CreatedAt   string    `json:"created_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
-func NewUserRead(id uuid.UUID, tenantID, name, email string, displayName *string, createdAt string) *UserRead {
+func NewUserRead(id uuid.UUID, tenantID, name, email string, displayName *string, createdAt, updatedAt time.Time) *UserRead {
 	return &UserRead{
 		ID:          id,
 		TenantID:    tenantID,
@@ -19,5 +24,6 @@ func NewUserRead(id uuid.UUID, tenantID, name, email string, displayName *string
 		Email:       email,
 		DisplayName: displayName,
 		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
 	}
 }
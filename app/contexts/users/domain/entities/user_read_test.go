@@ -16,7 +16,8 @@ type UserReadTestSuite struct {
 	testTenantID  string
 	testName      string
 	testEmail     string
-	testCreatedAt string
+	testCreatedAt time.Time
+	testUpdatedAt time.Time
 }
 
 func (suite *UserReadTestSuite) SetupTest() {
@@ -24,7 +25,8 @@ func (suite *UserReadTestSuite) SetupTest() {
 	suite.testTenantID = "tenant-123"
 	suite.testName = "John Doe"
 	suite.testEmail = "john.doe@example.com"
-	suite.testCreatedAt = time.Now().Format(time.RFC3339)
+	suite.testCreatedAt = time.Now().UTC()
+	suite.testUpdatedAt = suite.testCreatedAt.Add(time.Minute)
 }
 
 func TestUserReadTestSuite(t *testing.T) {
@@ -43,6 +45,7 @@ func (suite *UserReadTestSuite) TestNewUserRead_WithDisplayName() {
 		suite.testEmail,
 		&displayName,
 		suite.testCreatedAt,
+		suite.testUpdatedAt,
 	)
 
 	// Assert
@@ -54,6 +57,7 @@ func (suite *UserReadTestSuite) TestNewUserRead_WithDisplayName() {
 	assert.NotNil(suite.T(), userRead.DisplayName)
 	assert.Equal(suite.T(), displayName, *userRead.DisplayName)
 	assert.Equal(suite.T(), suite.testCreatedAt, userRead.CreatedAt)
+	assert.Equal(suite.T(), suite.testUpdatedAt, userRead.UpdatedAt)
 }
 
 func (suite *UserReadTestSuite) TestNewUserRead_WithoutDisplayName() {
@@ -65,6 +69,7 @@ func (suite *UserReadTestSuite) TestNewUserRead_WithoutDisplayName() {
 		suite.testEmail,
 		nil,
 		suite.testCreatedAt,
+		suite.testUpdatedAt,
 	)
 
 	// Assert
@@ -106,10 +111,10 @@ func TestNewUserRead_EmptyValues(t *testing.T) {
 	emptyTenantID := ""
 	emptyName := ""
 	emptyEmail := ""
-	emptyCreatedAt := ""
+	var emptyCreatedAt time.Time
 
 	// Act
-	userRead := entities.NewUserRead(id, emptyTenantID, emptyName, emptyEmail, nil, emptyCreatedAt)
+	userRead := entities.NewUserRead(id, emptyTenantID, emptyName, emptyEmail, nil, emptyCreatedAt, emptyCreatedAt)
 
 	// Assert
 	assert.NotNil(t, userRead)
@@ -128,10 +133,10 @@ func TestNewUserRead_WithLongDisplayName(t *testing.T) {
 	name := "Jane Doe"
 	email := "jane.doe@example.com"
 	longDisplayName := "This is a very long display name that might be used in some edge cases"
-	createdAt := time.Now().Format(time.RFC3339)
+	createdAt := time.Now()
 
 	// Act
-	userRead := entities.NewUserRead(id, tenantID, name, email, &longDisplayName, createdAt)
+	userRead := entities.NewUserRead(id, tenantID, name, email, &longDisplayName, createdAt, createdAt)
 
 	// Assert
 	assert.NotNil(t, userRead)
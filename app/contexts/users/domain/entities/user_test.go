@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -217,3 +219,125 @@ func TestUser_StructFields(t *testing.T) {
 	assert.Equal(t, createdAt, user.CreatedAt)
 	assert.Equal(t, updatedAt, user.UpdatedAt)
 }
+
+func (suite *UserTestSuite) TestRename_UpdatesNameAndTimestamp() {
+	// Arrange
+	user, _ := entities.NewUser(suite.testTenantID, suite.testName, suite.testEmail, suite.testPassword, nil)
+	previous := user.UpdatedAt
+	time.Sleep(1 * time.Millisecond)
+
+	// Act
+	err := user.Rename("  Johnny Doe ")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Johnny Doe", user.Name)
+	assert.True(suite.T(), user.UpdatedAt.After(previous))
+	assert.Equal(suite.T(), previous, user.CreatedAt)
+}
+
+func (suite *UserTestSuite) TestRename_RejectsBlankName() {
+	// Arrange
+	user, _ := entities.NewUser(suite.testTenantID, suite.testName, suite.testEmail, suite.testPassword, nil)
+	previous := user.UpdatedAt
+
+	// Act
+	err := user.Rename("   ")
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, exceptions.ErrInvalidName)
+	assert.Equal(suite.T(), suite.testName, user.Name)
+	assert.Equal(suite.T(), previous, user.UpdatedAt)
+}
+
+func (suite *UserTestSuite) TestChangeDisplayName_UpdatesTimestamp() {
+	// Arrange
+	user, _ := entities.NewUser(suite.testTenantID, suite.testName, suite.testEmail, suite.testPassword, nil)
+	previous := user.UpdatedAt
+	displayName := "JD"
+	time.Sleep(1 * time.Millisecond)
+
+	// Act
+	user.ChangeDisplayName(&displayName)
+
+	// Assert
+	assert.Equal(suite.T(), &displayName, user.DisplayName)
+	assert.True(suite.T(), user.UpdatedAt.After(previous))
+}
+
+func (suite *UserTestSuite) TestDelete_MarksAsDeleted() {
+	// Arrange
+	user, _ := entities.NewUser(suite.testTenantID, suite.testName, suite.testEmail, suite.testPassword, nil)
+
+	// Act
+	user.Delete()
+
+	// Assert
+	assert.True(suite.T(), user.IsDeleted())
+	assert.Equal(suite.T(), suite.testName, user.Name)
+}
+
+func (suite *UserTestSuite) TestErase_AnonymizesPersonalData() {
+	// Arrange
+	displayName := "Johnny"
+	user, _ := entities.NewUser(suite.testTenantID, suite.testName, suite.testEmail, suite.testPassword, &displayName)
+
+	// Act
+	err := user.Erase()
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.ErasedUserName, user.Name)
+	assert.Equal(suite.T(), entities.AnonymizedEmail(user.ID), user.Email.Value())
+	assert.Nil(suite.T(), user.DisplayName)
+	assert.Empty(suite.T(), user.Password.Hash())
+	assert.True(suite.T(), user.IsDeleted())
+}
+
+func (suite *UserTestSuite) TestErase_KeepsOriginalDeletionDate() {
+	// Arrange
+	user, _ := entities.NewUser(suite.testTenantID, suite.testName, suite.testEmail, suite.testPassword, nil)
+	user.Delete()
+	deletedAt := *user.DeletedAt
+	time.Sleep(1 * time.Millisecond)
+
+	// Act
+	err := user.Erase()
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), deletedAt, *user.DeletedAt)
+}
+
+func TestNewUser_DefaultsToMemberRole(t *testing.T) {
+	// Arrange
+	email, _ := value_objects.NewEmail("test@example.com")
+
+	// Act
+	user, err := entities.NewUser("tenant-123", "Test User", email, value_objects.NewPasswordFromHash("hash"), nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, authorization.RoleMember, user.Role)
+}
+
+func TestUser_PromoteToAdmin(t *testing.T) {
+	email, _ := value_objects.NewEmail("test@example.com")
+	user, _ := entities.NewUser("platform", "Ops", email, value_objects.NewPasswordFromHash("hash"), nil)
+
+	assert.NoError(t, user.PromoteToAdmin(authorization.RolePlatformAdmin))
+	assert.Equal(t, authorization.RolePlatformAdmin, user.Role)
+
+	assert.NoError(t, user.PromoteToAdmin(authorization.RoleTenantAdmin))
+	assert.Equal(t, authorization.RoleTenantAdmin, user.Role)
+}
+
+func TestUser_PromoteToAdmin_RejectsOtherRoles(t *testing.T) {
+	email, _ := value_objects.NewEmail("test@example.com")
+	user, _ := entities.NewUser("platform", "Ops", email, value_objects.NewPasswordFromHash("hash"), nil)
+
+	for _, role := range []authorization.Role{authorization.RoleMember, "root", ""} {
+		assert.Equal(t, exceptions.ErrInvalidAdminRole, user.PromoteToAdmin(role))
+	}
+	assert.Equal(t, authorization.RoleMember, user.Role)
+}
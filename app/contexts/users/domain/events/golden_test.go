@@ -0,0 +1,138 @@
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Los ficheros de testdata fijan el formato en el broker de cada versión. Si
+// uno de estos tests falla, el cambio necesita una versión nueva y un upcaster.
+
+var (
+	goldenUserID     = uuid.MustParse("3b2f7c1d-5e8a-4f60-b9d2-8c4e1a7f0d23")
+	goldenOccurredOn = time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	goldenTimestamp  = time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+)
+
+func goldenBaseEvent(eventID, eventType string, version int) shared_events.BaseEvent {
+	return shared_events.BaseEvent{
+		EventIDValue:      eventID,
+		EventTypeValue:    eventType,
+		EventVersionValue: version,
+		AggregateIDValue:  goldenUserID.String(),
+		OccurredOnValue:   goldenOccurredOn,
+	}
+}
+
+func readGolden(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func assertMatchesGolden(t *testing.T, name string, event any) {
+	t.Helper()
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(readGolden(t, name)), string(data))
+}
+
+func TestGolden_UserCreatedV2(t *testing.T) {
+	displayName := "Johnny"
+	event := UserCreatedEvent{
+		BaseEvent: goldenBaseEvent("6f1c2a9e-0b7d-4c1e-9a35-2d8f4e6b7c01", UserCreatedEventType, UserCreatedEventVersion),
+		Data: UserCreatedData{
+			ID:          goldenUserID,
+			TenantID:    "tenant-123",
+			Name:        "John Doe",
+			Email:       "john.doe@example.com",
+			DisplayName: &displayName,
+			Role:        "member",
+			CreatedAt:   goldenTimestamp,
+		},
+	}
+
+	assertMatchesGolden(t, "user_created_v2.json", event)
+}
+
+func TestGolden_UserCreatedV1UpcastsToV2(t *testing.T) {
+	upcasted, err := UserCreatedUpcasters.Upcast(readGolden(t, "user_created_v1.json"))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(readGolden(t, "user_created_v2.json")), string(upcasted))
+
+	var event UserCreatedEvent
+	require.NoError(t, json.Unmarshal(upcasted, &event))
+	assert.Equal(t, UserCreatedEventVersion, event.EventVersion())
+	assert.Equal(t, "member", event.Data.Role)
+}
+
+func TestGolden_UserCreatedV2IsNotUpcasted(t *testing.T) {
+	golden := readGolden(t, "user_created_v2.json")
+
+	upcasted, err := UserCreatedUpcasters.Upcast(golden)
+	require.NoError(t, err)
+
+	assert.Equal(t, golden, upcasted)
+}
+
+func TestGolden_UserUpdatedV1(t *testing.T) {
+	displayName := "Johnny"
+	event := UserUpdatedEvent{
+		BaseEvent: goldenBaseEvent("6f1c2a9e-0b7d-4c1e-9a35-2d8f4e6b7c02", UserUpdatedEventType, UserUpdatedEventVersion),
+		Data: UserUpdatedData{
+			ID:          goldenUserID,
+			TenantID:    "tenant-123",
+			Name:        "John Doe",
+			DisplayName: &displayName,
+			UpdatedAt:   goldenTimestamp,
+		},
+	}
+
+	assertMatchesGolden(t, "user_updated_v1.json", event)
+}
+
+func TestGolden_UserDeletedV1(t *testing.T) {
+	event := UserDeletedEvent{
+		BaseEvent: goldenBaseEvent("6f1c2a9e-0b7d-4c1e-9a35-2d8f4e6b7c03", UserDeletedEventType, UserDeletedEventVersion),
+		Data: UserDeletedData{
+			ID:        goldenUserID,
+			TenantID:  "tenant-123",
+			DeletedAt: goldenTimestamp,
+		},
+	}
+
+	assertMatchesGolden(t, "user_deleted_v1.json", event)
+}
+
+func TestGolden_UserErasedV1(t *testing.T) {
+	event := UserErasedEvent{
+		BaseEvent: goldenBaseEvent("6f1c2a9e-0b7d-4c1e-9a35-2d8f4e6b7c04", UserErasedEventType, UserErasedEventVersion),
+		Data: UserErasedData{
+			ID:       goldenUserID,
+			TenantID: "tenant-123",
+			ErasedAt: goldenTimestamp,
+		},
+	}
+
+	assertMatchesGolden(t, "user_erased_v1.json", event)
+}
+
+func TestGolden_TimestampsWithOffsetAreParsed(t *testing.T) {
+	// Productores antiguos formateaban las fechas en la zona local
+	payload := []byte(`{"id":"3b2f7c1d-5e8a-4f60-b9d2-8c4e1a7f0d23","tenant_id":"tenant-123","name":"John Doe","updated_at":"2025-01-15T11:30:00+01:00"}`)
+
+	var data UserUpdatedData
+	require.NoError(t, json.Unmarshal(payload, &data))
+
+	assert.True(t, goldenTimestamp.Equal(data.UpdatedAt))
+}
@@ -4,24 +4,63 @@ import (
 	"time"
 
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/google/uuid"
 )
 
+// Versiones de user.created:
+//   - v1: payload sin rol
+//   - v2: añade role
+const (
+	UserCreatedEventType    = "user.created"
+	UserCreatedEventVersion = 2
+)
+
+// UserCreatedData es el contrato del payload en el broker. No reutiliza
+// entidades para que un cambio en ellas no altere el formato sin versionarlo.
+// Las fechas viajan como cadenas RFC3339, que es como time.Time se serializa,
+// así que los payloads anteriores a que fueran time.Time se leen igual.
+type UserCreatedData struct {
+	ID          uuid.UUID `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	DisplayName *string   `json:"display_name,omitempty"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
 type UserCreatedEvent struct {
 	shared_events.BaseEvent
-	Data entities.UserRead `json:"data"`
+	Data UserCreatedData `json:"data"`
 }
 
 func NewUserCreatedEvent(user *entities.User) UserCreatedEvent {
 	return UserCreatedEvent{
-		BaseEvent: shared_events.NewBaseEvent("user.created", user.ID.String()),
-		Data: entities.UserRead{
+		BaseEvent: shared_events.NewBaseEvent(UserCreatedEventType, UserCreatedEventVersion, user.ID.String()),
+		Data: UserCreatedData{
 			ID:          user.ID,
 			TenantID:    user.TenantID,
 			Name:        user.Name,
 			Email:       user.Email.Value(),
 			DisplayName: user.DisplayName,
-			CreatedAt:   user.CreatedAt.Format(time.RFC3339),
+			Role:        string(user.Role),
+			CreatedAt:   user.CreatedAt.UTC(),
 		},
 	}
 }
+
+// UserCreatedUpcasters permite leer eventos publicados con versiones
+// anteriores de UserCreatedData.
+var UserCreatedUpcasters = shared_events.UpcasterChain{
+	1: upcastUserCreatedV1,
+}
+
+// Antes de v2 todos los usuarios se daban de alta como miembros.
+func upcastUserCreatedV1(data map[string]any) error {
+	if _, ok := data["role"]; !ok {
+		data["role"] = string(authorization.RoleMember)
+	}
+	return nil
+}
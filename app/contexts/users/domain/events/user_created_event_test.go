@@ -74,17 +74,18 @@ func (suite *UserCreatedEventTestSuite) TestNewUserCreatedEvent_MapsUserDataCorr
 	assert.Equal(suite.T(), suite.testUser.Name, event.Data.Name)
 	assert.Equal(suite.T(), suite.testUser.Email.Value(), event.Data.Email)
 	assert.Equal(suite.T(), suite.testUser.DisplayName, event.Data.DisplayName)
+	assert.Equal(suite.T(), string(suite.testUser.Role), event.Data.Role)
+	assert.Equal(suite.T(), UserCreatedEventVersion, event.EventVersion())
 	assert.NotEmpty(suite.T(), event.Data.CreatedAt)
 }
 
-func (suite *UserCreatedEventTestSuite) TestNewUserCreatedEvent_FormatsCreatedAtCorrectly() {
+func (suite *UserCreatedEventTestSuite) TestNewUserCreatedEvent_CreatedAtInUTC() {
 	// Act
 	event := NewUserCreatedEvent(suite.testUser)
 
-	// Assert - Verify RFC3339 format
-	_, err := time.Parse(time.RFC3339, event.Data.CreatedAt)
-	assert.NoError(suite.T(), err)
-
+	// Assert
+	assert.True(suite.T(), suite.testUser.CreatedAt.Equal(event.Data.CreatedAt))
+	assert.Equal(suite.T(), time.UTC, event.Data.CreatedAt.Location())
 }
 
 func (suite *UserCreatedEventTestSuite) TestNewUserCreatedEvent_WithoutDisplayName() {
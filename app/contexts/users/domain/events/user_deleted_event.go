@@ -0,0 +1,36 @@
+package events
+
+import (
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/google/uuid"
+)
+
+type UserDeletedData struct {
+	ID        uuid.UUID `json:"id"`
+	TenantID  string    `json:"tenant_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+const (
+	UserDeletedEventType    = "user.deleted"
+	UserDeletedEventVersion = 1
+)
+
+type UserDeletedEvent struct {
+	shared_events.BaseEvent
+	Data UserDeletedData `json:"data"`
+}
+
+func NewUserDeletedEvent(user *entities.User) UserDeletedEvent {
+	return UserDeletedEvent{
+		BaseEvent: shared_events.NewBaseEvent(UserDeletedEventType, UserDeletedEventVersion, user.ID.String()),
+		Data: UserDeletedData{
+			ID:        user.ID,
+			TenantID:  user.TenantID,
+			DeletedAt: user.DeletedAt.UTC(),
+		},
+	}
+}
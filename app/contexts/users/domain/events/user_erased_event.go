@@ -0,0 +1,49 @@
+package events
+
+import (
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/google/uuid"
+)
+
+const (
+	UserErasedEventType    = "user.erased"
+	UserErasedEventVersion = 1
+)
+
+// UserErasedData no transporta datos personales: los consumidores derivan los
+// valores anonimizados a partir del ID.
+type UserErasedData struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID string    `json:"tenant_id"`
+	ErasedAt time.Time `json:"erased_at"`
+}
+
+type UserErasedEvent struct {
+	shared_events.BaseEvent
+	Data UserErasedData `json:"data"`
+}
+
+func NewUserErasedEvent(user *entities.User) UserErasedEvent {
+	return UserErasedEvent{
+		BaseEvent: shared_events.NewBaseEvent(UserErasedEventType, UserErasedEventVersion, user.ID.String()),
+		Data: UserErasedData{
+			ID:       user.ID,
+			TenantID: user.TenantID,
+			ErasedAt: user.UpdatedAt.UTC(),
+		},
+	}
+}
+
+// ErasedUserFields son los campos con datos personales de user.created y
+// user.updated, con los valores del usuario ya anonimizado. Sirven para
+// reescribir los eventos de ese usuario que quedan guardados.
+func ErasedUserFields(user *entities.User) map[string]any {
+	return map[string]any{
+		"name":         user.Name,
+		"email":        user.Email.Value(),
+		"display_name": nil,
+	}
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	shared_events "github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/google/uuid"
+)
+
+type UserUpdatedData struct {
+	ID          uuid.UUID `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	Name        string    `json:"name"`
+	DisplayName *string   `json:"display_name,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+const (
+	UserUpdatedEventType    = "user.updated"
+	UserUpdatedEventVersion = 1
+)
+
+type UserUpdatedEvent struct {
+	shared_events.BaseEvent
+	Data UserUpdatedData `json:"data"`
+}
+
+func NewUserUpdatedEvent(user *entities.User) UserUpdatedEvent {
+	return UserUpdatedEvent{
+		BaseEvent: shared_events.NewBaseEvent(UserUpdatedEventType, UserUpdatedEventVersion, user.ID.String()),
+		Data: UserUpdatedData{
+			ID:          user.ID,
+			TenantID:    user.TenantID,
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+			UpdatedAt:   user.UpdatedAt.UTC(),
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserUpdatedEvent_MapsUserData(t *testing.T) {
+	// Arrange
+	email, _ := value_objects.NewEmail("john.doe@example.com")
+	displayName := "Johnny"
+	user, _ := entities.NewUser("tenant-123", "John Doe", email, value_objects.NewPasswordFromHash("hashed"), &displayName)
+
+	// Act
+	event := NewUserUpdatedEvent(user)
+
+	// Assert
+	assert.NotEmpty(t, event.EventID())
+	assert.Equal(t, "user.updated", event.EventType())
+	assert.Equal(t, user.ID.String(), event.AggregateID())
+	assert.Equal(t, user.ID, event.Data.ID)
+	assert.Equal(t, user.TenantID, event.Data.TenantID)
+	assert.Equal(t, user.Name, event.Data.Name)
+	assert.Equal(t, user.DisplayName, event.Data.DisplayName)
+
+	assert.True(t, user.UpdatedAt.Equal(event.Data.UpdatedAt))
+	assert.Equal(t, time.UTC, event.Data.UpdatedAt.Location())
+}
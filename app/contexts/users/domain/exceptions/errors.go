@@ -8,4 +8,14 @@ var (
 	ErrUserNotFound   = base_exceptions.NewNotFoundError("user not found", "")
 	ErrDuplicateEmail = base_exceptions.NewConflictError("email already exists", "")
 	ErrInvalidUuid    = base_exceptions.NewBadRequestError("invalid user id", "")
+	ErrInvalidName    = base_exceptions.NewBadRequestError("name cannot be empty", "")
+	ErrEmptyUpdate    = base_exceptions.NewBadRequestError("no fields to update", "")
+
+	ErrUserAccessDenied = base_exceptions.NewForbiddenError("not allowed to access this user", "")
+
+	ErrInvalidCursor       = base_exceptions.NewBadRequestError("invalid cursor", "")
+	ErrInvalidSortOrder    = base_exceptions.NewBadRequestError("invalid sort order", "order must be 'asc' or 'desc'")
+	ErrInvalidDateRange    = base_exceptions.NewBadRequestError("invalid date range", "created_from must not be after created_to")
+	ErrInvalidDeletionMode = base_exceptions.NewBadRequestError("invalid deletion mode", "mode must be 'soft' or 'erase'")
+	ErrInvalidAdminRole    = base_exceptions.NewBadRequestError("invalid admin role", "role must be 'platform_admin' or 'tenant_admin'")
 )
@@ -2,12 +2,43 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
 	"github.com/google/uuid"
 )
 
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// UserReadCursor identifica la última fila devuelta; la siguiente página
+// empieza estrictamente después de (CreatedAt, ID) en el orden solicitado.
+type UserReadCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+type UserReadListCriteria struct {
+	TenantID     string
+	EmailPrefix  string
+	NameContains string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	Order        SortOrder
+	After        *UserReadCursor
+	Limit        int
+}
+
 type UserReadRepository interface {
 	FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*entities.UserRead, error)
+	List(ctx context.Context, criteria UserReadListCriteria) ([]*entities.UserRead, error)
+	// Upsert no sobrescribe una fila con un UpdatedAt igual o posterior: con
+	// los reintentos los eventos pueden llegar desordenados
 	Upsert(ctx context.Context, dto *entities.UserRead) error
+	Delete(ctx context.Context, tenantID string, id uuid.UUID) error
+	Anonymize(ctx context.Context, tenantID string, id uuid.UUID, name, email string) error
 }
@@ -10,7 +10,11 @@ import (
 
 type UserRepository interface {
 	Save(ctx context.Context, user *entities.User) error
+	Update(ctx context.Context, user *entities.User) error
+	SoftDelete(ctx context.Context, user *entities.User) error
+	Anonymize(ctx context.Context, user *entities.User) error
 	FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error)
+	FindByIDIncludingDeleted(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error)
 	FindByEmail(ctx context.Context, tenantID string, email value_objects.Email) (*entities.User, error)
 	ExistsByEmail(ctx context.Context, tenantID string, email value_objects.Email) (bool, error)
 }
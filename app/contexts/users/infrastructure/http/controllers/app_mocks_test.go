@@ -6,10 +6,15 @@ import (
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/queries"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/infrastructure/http/controllers"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/featureflags"
 	shared_middleware "github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/tenancy"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -23,6 +28,21 @@ func (m *MockUserRepository) Save(ctx context.Context, user *entities.User) erro
 	return args.Error(0)
 }
 
+func (m *MockUserRepository) Update(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) SoftDelete(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) Anonymize(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
 func (m *MockUserRepository) FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
 	args := m.Called(ctx, tenantID, id)
 	if v := args.Get(0); v != nil {
@@ -31,6 +51,14 @@ func (m *MockUserRepository) FindByID(ctx context.Context, tenantID string, id u
 	return nil, args.Error(1)
 }
 
+func (m *MockUserRepository) FindByIDIncludingDeleted(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
+	args := m.Called(ctx, tenantID, id)
+	if v := args.Get(0); v != nil {
+		return v.(*entities.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *MockUserRepository) FindByEmail(ctx context.Context, tenantID string, email value_objects.Email) (*entities.User, error) {
 	args := m.Called(ctx, tenantID, email)
 	if v := args.Get(0); v != nil {
@@ -59,6 +87,23 @@ func (m *MockUserReadRepository) Upsert(ctx context.Context, dto *entities.UserR
 	return args.Error(0)
 }
 
+func (m *MockUserReadRepository) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+func (m *MockUserReadRepository) Anonymize(ctx context.Context, tenantID string, id uuid.UUID, name, email string) error {
+	args := m.Called(ctx, tenantID, id, name, email)
+	return args.Error(0)
+}
+func (m *MockUserReadRepository) List(ctx context.Context, criteria ports.UserReadListCriteria) ([]*entities.UserRead, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.UserRead), args.Error(1)
+}
+
 type MockIdempotencyRepository struct{ mock.Mock }
 
 func (m *MockIdempotencyRepository) IsProcessed(ctx context.Context, tenantID, key string) (bool, error) {
@@ -78,6 +123,13 @@ func (m *MockEventBus) Publish(ctx context.Context, event shared_ports.DomainEve
 }
 func (m *MockEventBus) Close() { m.Called() }
 
+// nopEventRedactor: los controllers no tienen outbox que reescribir
+type nopEventRedactor struct{}
+
+func (nopEventRedactor) Redact(ctx context.Context, aggregateID string, fields map[string]any) error {
+	return nil
+}
+
 type MockHasher struct{ mock.Mock }
 
 func (m *MockHasher) Hash(plainPassword string) (string, error) {
@@ -97,17 +149,35 @@ type deps struct {
 	hasher       *MockHasher
 }
 
+// testPrincipal simula lo que AuthMiddleware deja en c.Locals. Por defecto el
+// llamante es administrador; X-Test-Role y X-Test-User-Id lo sustituyen.
+func testPrincipal(c *fiber.Ctx) error {
+	c.Locals("role", c.Get("X-Test-Role", string(authorization.RoleTenantAdmin)))
+	c.Locals("user_id", c.Get("X-Test-User-Id", uuid.NewString()))
+	return c.Next()
+}
+
 func setupAppWithDeps(d deps) *fiber.App {
 
-	createUseCase := commands.NewCreateUserUseCase(d.userRepo, d.idem, d.bus, d.hasher)
+	txManager := transaction.NewInMemoryTransactionManager()
+	flags := featureflags.NewInMemoryFeatureFlags()
+	flags.Set(commands.FeatureDisplayName, "", true)
+	createUseCase := commands.NewCreateUserUseCase(d.userRepo, d.idem, d.bus, d.hasher, txManager, flags)
+	updateUseCase := commands.NewUpdateUserUseCase(d.userRepo, d.bus, txManager, flags)
+	deleteUseCase := commands.NewDeleteUserUseCase(d.userRepo, d.bus, nopEventRedactor{}, txManager)
 	getUseCase := queries.NewGetUserUseCase(d.userReadRepo)
+	listUseCase := queries.NewListUsersUseCase(d.userReadRepo)
 
 	app := fiber.New(
 		fiber.Config{ErrorHandler: shared_middleware.ErrorHandler(zap.NewNop())},
 	)
-	app.Use(shared_middleware.TenantMiddleware())
+	app.Use(shared_middleware.TenantMiddleware(tenancy.NewInMemoryTenantLookup(shared_ports.TenantActive)))
 	app.Use(shared_middleware.CorrelationIDMiddleware())
+	app.Use(testPrincipal)
 	app.Post("/users", controllers.CreateUserController(createUseCase))
+	app.Get("/users", controllers.ListUsersController(listUseCase))
 	app.Get("/users/:id", controllers.GetUserController(getUseCase))
+	app.Patch("/users/:id", controllers.UpdateUserController(updateUseCase))
+	app.Delete("/users/:id", controllers.DeleteUserController(deleteUseCase))
 	return app
 }
@@ -25,11 +25,6 @@ func CreateUserController(useCase *commands.CreateUserUseCase) fiber.Handler {
 		correlationID := c.Locals("correlation_id").(string)
 		idempotencyKey := c.Get("X-Idempotency-Key")
 
-		// Feature flag: display_name
-		if req.DisplayName != nil && !isFeatureEnabled(c, "display_name") {
-			req.DisplayName = nil
-		}
-
 		cmd := commands.CreateUserCommand{
 			TenantID:       tenantID,
 			IdempotencyKey: idempotencyKey,
@@ -40,7 +35,7 @@ func CreateUserController(useCase *commands.CreateUserUseCase) fiber.Handler {
 			DisplayName:    req.DisplayName,
 		}
 
-		resp, err := useCase.Execute(c.Context(), cmd)
+		resp, err := useCase.Execute(c.UserContext(), cmd)
 		if err != nil {
 			return err
 		}
@@ -51,13 +46,3 @@ func CreateUserController(useCase *commands.CreateUserUseCase) fiber.Handler {
 		})
 	}
 }
-
-func isFeatureEnabled(c *fiber.Ctx, feature string) bool {
-
-	if feature == "display_name" {
-		tenantID := c.Locals("tenant_id").(string)
-		return tenantID != "tenant-50"
-	}
-
-	return true
-}
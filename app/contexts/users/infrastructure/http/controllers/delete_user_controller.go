@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func DeleteUserController(useCase *commands.DeleteUserUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return exceptions.ErrInvalidUuid
+		}
+
+		tenantID := c.Locals("tenant_id").(string)
+		correlationID := c.Locals("correlation_id").(string)
+
+		cmd := commands.DeleteUserCommand{
+			TenantID:      tenantID,
+			UserID:        userID,
+			CorrelationID: correlationID,
+			Mode:          commands.DeletionMode(c.Query("mode", string(commands.DeletionModeSoft))),
+		}
+
+		if err := useCase.Execute(c.UserContext(), cmd); err != nil {
+			return err
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
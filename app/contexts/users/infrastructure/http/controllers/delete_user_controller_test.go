@@ -0,0 +1,68 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteUserController_SoftDeleteByDefault(t *testing.T) {
+	d := deps{userRepo: new(MockUserRepository), bus: new(MockEventBus)}
+	app := setupAppWithDeps(d)
+
+	user := existingUser("tenant-1")
+	d.userRepo.On("FindByID", mock.Anything, "tenant-1", user.ID).Return(user, nil).Once()
+	d.userRepo.On("SoftDelete", mock.Anything, user).Return(nil).Once()
+	d.bus.On("Publish", mock.Anything, mock.Anything, "corr-1").Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+user.ID.String(), nil)
+	req.Header.Set("X-Tenant-Id", "tenant-1")
+	req.Header.Set("X-Correlation-Id", "corr-1")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	d.userRepo.AssertExpectations(t)
+	d.bus.AssertExpectations(t)
+}
+
+func TestDeleteUserController_EraseMode(t *testing.T) {
+	d := deps{userRepo: new(MockUserRepository), bus: new(MockEventBus)}
+	app := setupAppWithDeps(d)
+
+	user := existingUser("tenant-1")
+	d.userRepo.On("FindByIDIncludingDeleted", mock.Anything, "tenant-1", user.ID).Return(user, nil).Once()
+	d.userRepo.On("Anonymize", mock.Anything, user).Return(nil).Once()
+	d.bus.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+user.ID.String()+"?mode=erase", nil)
+	req.Header.Set("X-Tenant-Id", "tenant-1")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, entities.ErasedUserName, user.Name)
+	d.userRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserController_InvalidMode(t *testing.T) {
+	d := deps{userRepo: new(MockUserRepository), bus: new(MockEventBus)}
+	app := setupAppWithDeps(d)
+
+	user := existingUser("tenant-1")
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+user.ID.String()+"?mode=purge", nil)
+	req.Header.Set("X-Tenant-Id", "tenant-1")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var payload map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+	assert.Equal(t, "invalid deletion mode", payload["message"])
+}
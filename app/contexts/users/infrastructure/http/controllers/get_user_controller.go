@@ -3,6 +3,7 @@ package controllers
 import (
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/queries"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
@@ -19,9 +20,10 @@ func GetUserController(useCase *queries.GetUserUseCase) fiber.Handler {
 		query := queries.GetUserQuery{
 			TenantID: tenantID,
 			UserID:   userID,
+			Actor:    middleware.PrincipalFrom(c),
 		}
 
-		user, err := useCase.Execute(c.Context(), query)
+		user, err := useCase.Execute(c.UserContext(), query)
 		if err != nil {
 			return err
 		}
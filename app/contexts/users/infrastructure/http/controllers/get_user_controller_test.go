@@ -28,7 +28,8 @@ func TestGetUserController_Success(t *testing.T) {
 		"John Doe",
 		"john.doe@example.com",
 		&display,
-		time.Now().Format(time.RFC3339),
+		time.Now(),
+		time.Now(),
 	)
 
 	d.userReadRepo.On("FindByID", mock.Anything, tenantID, userID).Return(expectedUser, nil).Once()
@@ -99,3 +100,38 @@ func TestGetUserController_NotFound(t *testing.T) {
 
 	d.userReadRepo.AssertExpectations(t)
 }
+
+func TestGetUserController_MemberReadingAnotherUser(t *testing.T) {
+	d := deps{userReadRepo: new(MockUserReadRepository)}
+	app := setupAppWithDeps(d)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+uuid.NewString(), nil)
+	req.Header.Set("X-Tenant-Id", "tenant-123")
+	req.Header.Set("X-Test-Role", "member")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	d.userReadRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetUserController_MemberReadingOwnProfile(t *testing.T) {
+	d := deps{userReadRepo: new(MockUserReadRepository)}
+	app := setupAppWithDeps(d)
+
+	tenantID := "tenant-123"
+	userID := uuid.New()
+	user := entities.NewUserRead(userID, tenantID, "John Doe", "john.doe@example.com", nil, time.Now(), time.Now())
+
+	d.userReadRepo.On("FindByID", mock.Anything, tenantID, userID).Return(user, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+userID.String(), nil)
+	req.Header.Set("X-Tenant-Id", tenantID)
+	req.Header.Set("X-Test-Role", "member")
+	req.Header.Set("X-Test-User-Id", userID.String())
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	d.userReadRepo.AssertExpectations(t)
+}
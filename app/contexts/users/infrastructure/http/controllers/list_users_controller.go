@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/queries"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+func ListUsersController(useCase *queries.ListUsersUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		tenantID := c.Locals("tenant_id").(string)
+
+		createdFrom, err := parseTimeQuery(c, "created_from")
+		if err != nil {
+			return err
+		}
+
+		createdTo, err := parseTimeQuery(c, "created_to")
+		if err != nil {
+			return err
+		}
+
+		query := queries.ListUsersQuery{
+			TenantID:     tenantID,
+			Cursor:       c.Query("cursor"),
+			Limit:        c.QueryInt("limit", queries.DefaultListUsersLimit),
+			EmailPrefix:  c.Query("email_prefix"),
+			NameContains: c.Query("name"),
+			CreatedFrom:  createdFrom,
+			CreatedTo:    createdTo,
+			Order:        c.Query("order"),
+		}
+
+		response, err := useCase.Execute(c.UserContext(), query)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(response)
+	}
+}
+
+// parseTimeQuery lee un parámetro opcional en formato RFC3339
+func parseTimeQuery(c *fiber.Ctx, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, exceptions.NewBadRequestError("invalid "+key, "expected RFC3339 format")
+	}
+
+	return &parsed, nil
+}
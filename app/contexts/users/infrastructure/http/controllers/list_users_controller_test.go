@@ -0,0 +1,74 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListUsersController_Success(t *testing.T) {
+	d := deps{userReadRepo: new(MockUserReadRepository)}
+	app := setupAppWithDeps(d)
+
+	tenantID := "tenant-123"
+	user := entities.NewUserRead(uuid.New(), tenantID, "John Doe", "john.doe@example.com", nil, time.Now(), time.Now())
+
+	d.userReadRepo.On("List", mock.Anything, mock.MatchedBy(func(c ports.UserReadListCriteria) bool {
+		return c.TenantID == tenantID &&
+			c.EmailPrefix == "john" &&
+			c.NameContains == "Doe" &&
+			c.CreatedFrom != nil &&
+			c.Order == ports.SortAsc &&
+			c.Limit == 6
+	})).Return([]*entities.UserRead{user}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/users?limit=5&email_prefix=john&name=Doe&order=asc&created_from=2025-01-01T00:00:00Z", nil)
+	req.Header.Set("X-Tenant-Id", tenantID)
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+
+	items := payload["items"].([]any)
+	assert.Len(t, items, 1)
+	assert.NotContains(t, payload, "next_cursor")
+	d.userReadRepo.AssertExpectations(t)
+}
+
+func TestListUsersController_InvalidDate(t *testing.T) {
+	d := deps{userReadRepo: new(MockUserReadRepository)}
+	app := setupAppWithDeps(d)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?created_to=yesterday", nil)
+	req.Header.Set("X-Tenant-Id", "tenant-123")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	d.userReadRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+func TestListUsersController_InvalidCursor(t *testing.T) {
+	d := deps{userReadRepo: new(MockUserReadRepository)}
+	app := setupAppWithDeps(d)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?cursor=%21%21", nil)
+	req.Header.Set("X-Tenant-Id", "tenant-123")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var payload map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+	assert.Equal(t, "invalid cursor", payload["message"])
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateUserRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	DisplayName *string `json:"display_name,omitempty"`
+}
+
+func UpdateUserController(useCase *commands.UpdateUserUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return exceptions.ErrInvalidUuid
+		}
+
+		var req UpdateUserRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return shared_exceptions.NewBadRequestError("invalid request body", err.Error())
+		}
+
+		tenantID := c.Locals("tenant_id").(string)
+		correlationID := c.Locals("correlation_id").(string)
+
+		cmd := commands.UpdateUserCommand{
+			TenantID:      tenantID,
+			UserID:        userID,
+			CorrelationID: correlationID,
+			Name:          req.Name,
+			DisplayName:   req.DisplayName,
+		}
+
+		resp, err := useCase.Execute(c.UserContext(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(fiber.Map{
+			"user_id": resp.UserID,
+			"message": "User updated successfully",
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package controllers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func existingUser(tenantID string) *entities.User {
+	email, _ := value_objects.NewEmail("john@example.com")
+	return &entities.User{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		Name:      "John Doe",
+		Email:     email,
+		Password:  value_objects.NewPasswordFromHash("hashed"),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestUpdateUserController_Success(t *testing.T) {
+	d := deps{userRepo: new(MockUserRepository), bus: new(MockEventBus)}
+	app := setupAppWithDeps(d)
+
+	user := existingUser("tenant-1")
+	d.userRepo.On("FindByID", mock.Anything, "tenant-1", user.ID).Return(user, nil).Once()
+	d.userRepo.On("Update", mock.Anything, user).Return(nil).Once()
+	d.bus.On("Publish", mock.Anything, mock.Anything, "corr-1").Return(nil).Once()
+
+	body := `{"name":"Johnny Doe","display_name":"JD"}`
+	req := httptest.NewRequest(http.MethodPatch, "/users/"+user.ID.String(), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-Id", "tenant-1")
+	req.Header.Set("X-Correlation-Id", "corr-1")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+	assert.Equal(t, user.ID.String(), payload["user_id"])
+	assert.Equal(t, "User updated successfully", payload["message"])
+	assert.Equal(t, "Johnny Doe", user.Name)
+
+	d.userRepo.AssertExpectations(t)
+	d.bus.AssertExpectations(t)
+}
+
+func TestUpdateUserController_InvalidUUID(t *testing.T) {
+	d := deps{userRepo: new(MockUserRepository), bus: new(MockEventBus)}
+	app := setupAppWithDeps(d)
+
+	req := httptest.NewRequest(http.MethodPatch, "/users/invalid-uuid", bytes.NewBufferString(`{"name":"X"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-Id", "tenant-1")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	d.userRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateUserController_EmptyBody(t *testing.T) {
+	d := deps{userRepo: new(MockUserRepository), bus: new(MockEventBus)}
+	app := setupAppWithDeps(d)
+
+	req := httptest.NewRequest(http.MethodPatch, "/users/"+uuid.New().String(), bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-Id", "tenant-1")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var payload map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+	assert.Equal(t, "no fields to update", payload["message"])
+}
+
+func TestUpdateUserController_NotFound(t *testing.T) {
+	d := deps{userRepo: new(MockUserRepository), bus: new(MockEventBus)}
+	app := setupAppWithDeps(d)
+
+	userID := uuid.New()
+	d.userRepo.On("FindByID", mock.Anything, "tenant-1", userID).Return(nil, exceptions.ErrUserNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodPatch, "/users/"+userID.String(), bytes.NewBufferString(`{"name":"Johnny"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Tenant-Id", "tenant-1")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	d.bus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -4,27 +4,58 @@ import (
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/queries"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/infrastructure/http/controllers"
-	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
 	"github.com/gofiber/fiber/v2"
 )
 
+// RegisterUserRoutes registra la API de usuarios. scope es
+// TenantScopeMiddleware: va después del rate limiter y de los permisos para que
+// las peticiones rechazadas no abran transacción.
 func RegisterUserRoutes(
 	app fiber.Router,
-	cfg *config.Config,
+	rateLimiter *middleware.RateLimiter,
+	scope fiber.Handler,
 	createUseCase *commands.CreateUserUseCase,
 	getUseCase *queries.GetUserUseCase,
+	updateUseCase *commands.UpdateUserUseCase,
+	deleteUseCase *commands.DeleteUserUseCase,
+	listUseCase *queries.ListUsersUseCase,
 ) {
 
 	users := app.Group("/v1/users")
 
 	users.Post("/",
-		middleware.RateLimiterMiddleware(cfg, 10),
+		rateLimiter.Limit(10),
+		scope,
 		controllers.CreateUserController(createUseCase),
 	)
 
+	users.Get("/",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionUsersList),
+		scope,
+		controllers.ListUsersController(listUseCase),
+	)
+
 	users.Get("/:id",
-		middleware.RateLimiterMiddleware(cfg, 10),
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionUsersRead),
+		scope,
 		controllers.GetUserController(getUseCase),
 	)
+
+	users.Patch("/:id",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionUsersUpdate),
+		scope,
+		controllers.UpdateUserController(updateUseCase),
+	)
+
+	users.Delete("/:id",
+		rateLimiter.Limit(10),
+		middleware.RequirePermission(authorization.PermissionUsersDelete),
+		scope,
+		controllers.DeleteUserController(deleteUseCase),
+	)
 }
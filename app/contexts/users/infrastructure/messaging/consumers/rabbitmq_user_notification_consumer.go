@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/notifications"
-	"github.com/carloscacb333/go-hexagonal/app/contexts/users/infrastructure/messaging/handlers"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
 	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/inbox"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/messaging"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/rabbitmq"
 	"go.uber.org/zap"
 )
@@ -18,18 +20,25 @@ type RabbitMQUserNotificationConsumer struct {
 func NewRabbitMQUserNotificationConsumer(
 	cfg *config.RabbitMQConfig,
 	logger *zap.Logger,
+	inboxRepository ports.InboxRepository,
+	txManager ports.TransactionManager,
 	notificationHandler *notifications.UserNotificationHandler,
 ) *RabbitMQUserNotificationConsumer {
 
-	eventHandler := handlers.NewUserNotificationEventHandler(notificationHandler)
+	registry := messaging.NewEventRegistry()
+	messaging.Register(registry, events.UserCreatedEventType, notificationHandler.Handle)
+	registry.Upcast(events.UserCreatedEventType, events.UserCreatedUpcasters)
+
+	// El nombre de la cola identifica al consumidor en el inbox
+	queueName := "user_notifications"
 
 	consumer := rabbitmq.NewRabbitMQConsumer(
 		cfg,
 		logger,
 		"domain_events",
-		"user_notifications",
-		[]string{"user.created"},
-		eventHandler,
+		queueName,
+		registry.EventTypes(),
+		inbox.NewInboxEventHandler(queueName, inboxRepository, txManager, registry),
 	)
 
 	return &RabbitMQUserNotificationConsumer{
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/projections"
-	"github.com/carloscacb333/go-hexagonal/app/contexts/users/infrastructure/messaging/handlers"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/events"
 	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/inbox"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/messaging"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/rabbitmq"
 	"go.uber.org/zap"
 )
@@ -18,18 +20,31 @@ type RabbitMQUserProjectionsConsumer struct {
 func NewRabbitMQUserProjectionsConsumer(
 	cfg *config.RabbitMQConfig,
 	logger *zap.Logger,
+	inboxRepository ports.InboxRepository,
+	txManager ports.TransactionManager,
 	userCreatedHandler *projections.UserCreatedHandler,
+	userUpdatedHandler *projections.UserUpdatedHandler,
+	userDeletedHandler *projections.UserDeletedHandler,
+	userErasedHandler *projections.UserErasedHandler,
 ) *RabbitMQUserProjectionsConsumer {
 
-	eventHandler := handlers.NewUserProjectionsEventHandler(userCreatedHandler)
+	registry := messaging.NewEventRegistry()
+	messaging.Register(registry, events.UserCreatedEventType, userCreatedHandler.Handle)
+	messaging.Register(registry, events.UserUpdatedEventType, userUpdatedHandler.Handle)
+	messaging.Register(registry, events.UserDeletedEventType, userDeletedHandler.Handle)
+	messaging.Register(registry, events.UserErasedEventType, userErasedHandler.Handle)
+	registry.Upcast(events.UserCreatedEventType, events.UserCreatedUpcasters)
+
+	// El nombre de la cola identifica al consumidor en el inbox
+	queueName := "user_projections"
 
 	consumer := rabbitmq.NewRabbitMQConsumer(
 		cfg,
 		logger,
 		"domain_events",
-		"user_projections",
-		[]string{"user.created"},
-		eventHandler,
+		queueName,
+		registry.EventTypes(),
+		inbox.NewInboxEventHandler(queueName, inboxRepository, txManager, registry),
 	)
 
 	return &RabbitMQUserProjectionsConsumer{
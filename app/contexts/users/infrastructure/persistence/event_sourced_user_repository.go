@@ -0,0 +1,187 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventSourcedUserRepository persiste User como un stream de eventos. Cada
+// escritura añade un evento con la versión que el agregado tenía al cargarse,
+// así que dos modificaciones concurrentes no pueden pisarse. Cada
+// snapshotEvery eventos se guarda un snapshot para acotar el replay.
+type EventSourcedUserRepository struct {
+	store         shared_ports.EventStore
+	emails        userEmailIndex
+	snapshotEvery int
+}
+
+func NewEventSourcedUserRepository(db *gorm.DB, store shared_ports.EventStore, snapshotEvery int) *EventSourcedUserRepository {
+	return newEventSourcedUserRepository(&gormUserEmailIndex{db: db}, store, snapshotEvery)
+}
+
+func newEventSourcedUserRepository(emails userEmailIndex, store shared_ports.EventStore, snapshotEvery int) *EventSourcedUserRepository {
+	return &EventSourcedUserRepository{
+		store:         store,
+		emails:        emails,
+		snapshotEvery: snapshotEvery,
+	}
+}
+
+func (r *EventSourcedUserRepository) Save(ctx context.Context, user *entities.User) error {
+	if err := r.emails.Reserve(ctx, user.TenantID, user.Email.Value(), user.ID); err != nil {
+		return err
+	}
+
+	return r.append(ctx, user, userRegisteredEvent, userRegistered{
+		Name:         user.Name,
+		Email:        user.Email.Value(),
+		PasswordHash: user.Password.Hash(),
+		DisplayName:  user.DisplayName,
+		Role:         string(user.Role),
+		CreatedAt:    user.CreatedAt,
+	})
+}
+
+func (r *EventSourcedUserRepository) Update(ctx context.Context, user *entities.User) error {
+	return r.append(ctx, user, userProfileChangedEvent, userProfileChanged{
+		Name:        user.Name,
+		DisplayName: user.DisplayName,
+		UpdatedAt:   user.UpdatedAt,
+	})
+}
+
+func (r *EventSourcedUserRepository) SoftDelete(ctx context.Context, user *entities.User) error {
+	return r.append(ctx, user, userDeletedEvent, userDeleted{
+		DeletedAt: *user.DeletedAt,
+	})
+}
+
+func (r *EventSourcedUserRepository) Anonymize(ctx context.Context, user *entities.User) error {
+	if err := r.emails.Replace(ctx, user.TenantID, user.ID, user.Email.Value()); err != nil {
+		return err
+	}
+
+	// Los eventos anteriores y el snapshot guardan nombre, email y hash de la
+	// contraseña: se reescriben con los datos ya anonimizados en la misma
+	// transacción
+	if err := r.redactStream(ctx, user); err != nil {
+		return err
+	}
+
+	return r.append(ctx, user, userErasedEvent, userErased{
+		Name:      user.Name,
+		Email:     user.Email.Value(),
+		DeletedAt: *user.DeletedAt,
+		UpdatedAt: user.UpdatedAt,
+	})
+}
+
+func (r *EventSourcedUserRepository) redactStream(ctx context.Context, user *entities.User) error {
+	events, err := r.store.Load(ctx, userAggregateType, user.ID.String(), 0)
+	if err != nil {
+		return err
+	}
+
+	payloads := make(map[int][]byte, len(events))
+	for _, event := range events {
+		payload, err := redactUserEvent(user, event)
+		if err != nil {
+			return shared_exceptions.NewInternalServerError("failed to redact user event", err.Error())
+		}
+		payloads[event.Version] = payload
+	}
+
+	return r.store.Redact(ctx, userAggregateType, user.ID.String(), payloads)
+}
+
+func (r *EventSourcedUserRepository) FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
+	user, err := r.FindByIDIncludingDeleted(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDeleted() {
+		return nil, exceptions.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (r *EventSourcedUserRepository) FindByIDIncludingDeleted(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
+	snapshot, err := r.store.LoadSnapshot(ctx, userAggregateType, id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	afterVersion := 0
+	if snapshot != nil {
+		afterVersion = snapshot.Version
+	}
+
+	events, err := r.store.Load(ctx, userAggregateType, id.String(), afterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := replayUser(snapshot, events)
+	if err != nil {
+		return nil, shared_exceptions.NewInternalServerError("failed to replay user stream", err.Error())
+	}
+	if user == nil || user.TenantID != tenantID {
+		return nil, exceptions.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (r *EventSourcedUserRepository) FindByEmail(ctx context.Context, tenantID string, email value_objects.Email) (*entities.User, error) {
+	id, ok, err := r.emails.Lookup(ctx, tenantID, email.Value())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, exceptions.ErrUserNotFound
+	}
+
+	return r.FindByID(ctx, tenantID, id)
+}
+
+func (r *EventSourcedUserRepository) ExistsByEmail(ctx context.Context, tenantID string, email value_objects.Email) (bool, error) {
+	_, err := r.FindByEmail(ctx, tenantID, email)
+	if err == exceptions.ErrUserNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *EventSourcedUserRepository) append(ctx context.Context, user *entities.User, eventType string, payload any) error {
+	event, err := newUserStreamEvent(user, eventType, payload)
+	if err != nil {
+		return shared_exceptions.NewInternalServerError("failed to encode user event", err.Error())
+	}
+
+	if err := r.store.Append(ctx, userAggregateType, user.Version, []shared_ports.StreamEvent{event}); err != nil {
+		return err
+	}
+	user.Version++
+
+	if r.snapshotEvery > 0 && user.Version%r.snapshotEvery == 0 {
+		snapshot, err := takeUserSnapshot(user)
+		if err != nil {
+			return shared_exceptions.NewInternalServerError("failed to encode user snapshot", err.Error())
+		}
+		if err := r.store.SaveSnapshot(ctx, userAggregateType, snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
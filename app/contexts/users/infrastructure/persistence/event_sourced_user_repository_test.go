@@ -0,0 +1,237 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/eventstore"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeUserEmailIndex struct {
+	entries map[string]uuid.UUID
+}
+
+func (i *fakeUserEmailIndex) Reserve(ctx context.Context, tenantID, email string, userID uuid.UUID) error {
+	key := tenantID + "/" + email
+	if _, ok := i.entries[key]; ok {
+		return exceptions.ErrDuplicateEmail
+	}
+	i.entries[key] = userID
+	return nil
+}
+
+func (i *fakeUserEmailIndex) Replace(ctx context.Context, tenantID string, userID uuid.UUID, email string) error {
+	for key, id := range i.entries {
+		if id == userID {
+			delete(i.entries, key)
+		}
+	}
+	i.entries[tenantID+"/"+email] = userID
+	return nil
+}
+
+func (i *fakeUserEmailIndex) Lookup(ctx context.Context, tenantID, email string) (uuid.UUID, bool, error) {
+	id, ok := i.entries[tenantID+"/"+email]
+	return id, ok, nil
+}
+
+type EventSourcedUserRepositoryTestSuite struct {
+	suite.Suite
+	store      *eventstore.InMemoryEventStore
+	repository *EventSourcedUserRepository
+	tenantID   string
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) SetupTest() {
+	suite.store = eventstore.NewInMemoryEventStore()
+	suite.repository = newEventSourcedUserRepository(&fakeUserEmailIndex{entries: map[string]uuid.UUID{}}, suite.store, 3)
+	suite.tenantID = "tenant-123"
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) newUser(email string) *entities.User {
+	emailVO, err := value_objects.NewEmail(email)
+	suite.Require().NoError(err)
+
+	user, err := entities.NewUser(suite.tenantID, "John Doe", emailVO, value_objects.NewPasswordFromHash("hashed"), nil)
+	suite.Require().NoError(err)
+	return user
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestSaveAndFindByID() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+	suite.Equal(1, user.Version)
+
+	found, err := suite.repository.FindByID(ctx, suite.tenantID, user.ID)
+	suite.Require().NoError(err)
+	suite.Equal(user.Name, found.Name)
+	suite.Equal(user.Email.Value(), found.Email.Value())
+	suite.Equal("hashed", found.Password.Hash())
+	suite.Equal(user.Role, found.Role)
+	suite.Equal(1, found.Version)
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestSaveRejectsDuplicateEmail() {
+	ctx := context.Background()
+	suite.Require().NoError(suite.repository.Save(ctx, suite.newUser("john@example.com")))
+
+	err := suite.repository.Save(ctx, suite.newUser("john@example.com"))
+
+	suite.Equal(exceptions.ErrDuplicateEmail, err)
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestFindByIDChecksTenant() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+
+	_, err := suite.repository.FindByID(ctx, "other-tenant", user.ID)
+
+	suite.Equal(exceptions.ErrUserNotFound, err)
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestUpdateReplaysChanges() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+
+	suite.Require().NoError(user.Rename("Jane Doe"))
+	suite.Require().NoError(suite.repository.Update(ctx, user))
+
+	found, err := suite.repository.FindByEmail(ctx, suite.tenantID, user.Email)
+	suite.Require().NoError(err)
+	suite.Equal("Jane Doe", found.Name)
+	suite.Equal(2, found.Version)
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestConcurrentUpdateConflicts() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+
+	first, err := suite.repository.FindByID(ctx, suite.tenantID, user.ID)
+	suite.Require().NoError(err)
+	second, err := suite.repository.FindByID(ctx, suite.tenantID, user.ID)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(first.Rename("First"))
+	suite.Require().NoError(suite.repository.Update(ctx, first))
+
+	suite.Require().NoError(second.Rename("Second"))
+	err = suite.repository.Update(ctx, second)
+
+	suite.Equal(eventstore.ErrConcurrencyConflict, err)
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestSoftDeleteHidesUser() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+
+	user.Delete()
+	suite.Require().NoError(suite.repository.SoftDelete(ctx, user))
+
+	_, err := suite.repository.FindByID(ctx, suite.tenantID, user.ID)
+	suite.Equal(exceptions.ErrUserNotFound, err)
+
+	found, err := suite.repository.FindByIDIncludingDeleted(ctx, suite.tenantID, user.ID)
+	suite.Require().NoError(err)
+	suite.True(found.IsDeleted())
+
+	exists, err := suite.repository.ExistsByEmail(ctx, suite.tenantID, user.Email)
+	suite.Require().NoError(err)
+	suite.False(exists)
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestAnonymizeReplacesEmail() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+	original := user.Email
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+
+	suite.Require().NoError(user.Erase())
+	suite.Require().NoError(suite.repository.Anonymize(ctx, user))
+
+	found, err := suite.repository.FindByIDIncludingDeleted(ctx, suite.tenantID, user.ID)
+	suite.Require().NoError(err)
+	suite.Equal(entities.ErasedUserName, found.Name)
+	suite.Equal(entities.AnonymizedEmail(user.ID), found.Email.Value())
+	suite.Empty(found.Password.Hash())
+
+	// El email original queda libre para un nuevo registro
+	suite.NoError(suite.repository.Save(ctx, suite.newUser(original.Value())))
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestAnonymizeRedactsStoredStream() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+
+	displayName := "Johnny"
+	user.ChangeDisplayName(&displayName)
+	suite.Require().NoError(user.Rename("Jane Roe"))
+	suite.Require().NoError(suite.repository.Update(ctx, user))
+	suite.Require().NoError(suite.repository.Update(ctx, user))
+
+	// Con snapshotEvery 3 ya hay un snapshot con los datos originales
+	snapshot, err := suite.store.LoadSnapshot(ctx, userAggregateType, user.ID.String())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(snapshot)
+
+	suite.Require().NoError(user.Erase())
+	suite.Require().NoError(suite.repository.Anonymize(ctx, user))
+
+	events, err := suite.store.Load(ctx, userAggregateType, user.ID.String(), 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 4)
+	for _, event := range events {
+		for _, pii := range []string{"John Doe", "Jane Roe", "Johnny", "john@example.com", "hashed"} {
+			suite.NotContains(string(event.Payload), pii, "event %d (%s)", event.Version, event.Type)
+		}
+	}
+
+	snapshot, err = suite.store.LoadSnapshot(ctx, userAggregateType, user.ID.String())
+	suite.Require().NoError(err)
+	suite.Nil(snapshot)
+
+	// El stream reescrito sigue reproduciéndose hasta el usuario anonimizado
+	found, err := suite.repository.FindByIDIncludingDeleted(ctx, suite.tenantID, user.ID)
+	suite.Require().NoError(err)
+	suite.Equal(entities.ErasedUserName, found.Name)
+	suite.Equal(entities.AnonymizedEmail(user.ID), found.Email.Value())
+	suite.Nil(found.DisplayName)
+	suite.Equal(4, found.Version)
+}
+
+func (suite *EventSourcedUserRepositoryTestSuite) TestSnapshotEveryNEvents() {
+	ctx := context.Background()
+	user := suite.newUser("john@example.com")
+	suite.Require().NoError(suite.repository.Save(ctx, user))
+
+	for _, name := range []string{"Second", "Third", "Fourth"} {
+		suite.Require().NoError(user.Rename(name))
+		suite.Require().NoError(suite.repository.Update(ctx, user))
+	}
+
+	snapshot, err := suite.store.LoadSnapshot(ctx, userAggregateType, user.ID.String())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(snapshot)
+	suite.Equal(3, snapshot.Version)
+
+	// La carga parte del snapshot y aplica solo el evento posterior
+	found, err := suite.repository.FindByID(ctx, suite.tenantID, user.ID)
+	suite.Require().NoError(err)
+	suite.Equal("Fourth", found.Name)
+	suite.Equal(4, found.Version)
+}
+
+func TestEventSourcedUserRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EventSourcedUserRepositoryTestSuite))
+}
@@ -2,12 +2,17 @@ package persistence
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/ports"
 	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GormUserReadRepository struct {
@@ -21,7 +26,7 @@ func NewGormUserReadRepository(db *gorm.DB) *GormUserReadRepository {
 func (r *GormUserReadRepository) FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*entities.UserRead, error) {
 	var model UserReadModel
 
-	err := r.db.WithContext(ctx).
+	err := transaction.DB(ctx, r.db).
 		Where("id = ? AND tenant_id = ?", id, tenantID).
 		First(&model).Error
 
@@ -39,9 +44,66 @@ func (r *GormUserReadRepository) FindByID(ctx context.Context, tenantID string,
 		model.Email,
 		model.DisplayName,
 		model.CreatedAt,
+		model.UpdatedAt,
 	), nil
 }
 
+func (r *GormUserReadRepository) List(ctx context.Context, criteria ports.UserReadListCriteria) ([]*entities.UserRead, error) {
+	query := transaction.DB(ctx, r.db).
+		Model(&UserReadModel{}).
+		Where("tenant_id = ?", criteria.TenantID)
+
+	if criteria.EmailPrefix != "" {
+		query = query.Where("email LIKE ?", escapeLike(strings.ToLower(criteria.EmailPrefix))+"%")
+	}
+	if criteria.NameContains != "" {
+		query = query.Where("name ILIKE ?", "%"+escapeLike(criteria.NameContains)+"%")
+	}
+	if criteria.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *criteria.CreatedFrom)
+	}
+	if criteria.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *criteria.CreatedTo)
+	}
+
+	direction := "ASC"
+	comparator := ">"
+	if criteria.Order == ports.SortDesc {
+		direction = "DESC"
+		comparator = "<"
+	}
+
+	if criteria.After != nil {
+		query = query.Where("(created_at, id) "+comparator+" (?, ?)", criteria.After.CreatedAt, criteria.After.ID)
+	}
+
+	var models []UserReadModel
+	err := query.
+		Order("created_at " + direction).
+		Order("id " + direction).
+		Limit(criteria.Limit).
+		Find(&models).Error
+
+	if err != nil {
+		return nil, shared_exceptions.NewInternalServerError("failed to list user read models", err.Error())
+	}
+
+	users := make([]*entities.UserRead, 0, len(models))
+	for _, model := range models {
+		users = append(users, entities.NewUserRead(
+			model.ID,
+			model.TenantID,
+			model.Name,
+			model.Email,
+			model.DisplayName,
+			model.CreatedAt,
+			model.UpdatedAt,
+		))
+	}
+
+	return users, nil
+}
+
 func (r *GormUserReadRepository) Upsert(ctx context.Context, dto *entities.UserRead) error {
 	model := &UserReadModel{
 		ID:          dto.ID,
@@ -50,13 +112,23 @@ func (r *GormUserReadRepository) Upsert(ctx context.Context, dto *entities.UserR
 		Email:       dto.Email,
 		DisplayName: dto.DisplayName,
 		CreatedAt:   dto.CreatedAt,
+		UpdatedAt:   dto.UpdatedAt,
 	}
 
-	// GORM upsert: Create or Update
-	err := r.db.WithContext(ctx).
-		Where("id = ? AND tenant_id = ?", dto.ID, dto.TenantID).
-		Assign(model).
-		FirstOrCreate(&model).Error
+	db := transaction.DB(ctx, r.db)
+	if err := lockUserReadForWrite(db, dto.TenantID); err != nil {
+		return shared_exceptions.NewInternalServerError("failed to upsert user read model", err.Error())
+	}
+
+	// Solo se actualiza si el evento es más reciente que la fila: un evento
+	// que vuelve de una cola de reintento no pisa uno posterior
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}, {Name: "tenant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "email", "display_name", "created_at", "updated_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "users_read.updated_at < excluded.updated_at"},
+		}},
+	}).Create(model).Error
 
 	if err != nil {
 		return shared_exceptions.NewInternalServerError("failed to upsert user read model", err.Error())
@@ -64,3 +136,48 @@ func (r *GormUserReadRepository) Upsert(ctx context.Context, dto *entities.UserR
 
 	return nil
 }
+
+func (r *GormUserReadRepository) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	db := transaction.DB(ctx, r.db)
+	if err := lockUserReadForWrite(db, tenantID); err != nil {
+		return shared_exceptions.NewInternalServerError("failed to delete user read model", err.Error())
+	}
+
+	err := db.
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		Delete(&UserReadModel{}).Error
+
+	if err != nil {
+		return shared_exceptions.NewInternalServerError("failed to delete user read model", err.Error())
+	}
+
+	return nil
+}
+
+func (r *GormUserReadRepository) Anonymize(ctx context.Context, tenantID string, id uuid.UUID, name, email string) error {
+	db := transaction.DB(ctx, r.db)
+	if err := lockUserReadForWrite(db, tenantID); err != nil {
+		return shared_exceptions.NewInternalServerError("failed to erase user read model", err.Error())
+	}
+
+	err := db.Unscoped().
+		Model(&UserReadModel{}).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		Updates(map[string]any{
+			"name":         name,
+			"email":        email,
+			"display_name": nil,
+			"updated_at":   time.Now(),
+			"deleted_at":   gorm.Expr("COALESCE(deleted_at, ?)", time.Now()),
+		}).Error
+
+	if err != nil {
+		return shared_exceptions.NewInternalServerError("failed to erase user read model", err.Error())
+	}
+
+	return nil
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
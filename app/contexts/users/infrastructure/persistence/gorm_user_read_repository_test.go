@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// GormUserReadRepositoryTestSuite proyecta contra Postgres real. Necesita
+// TEST_DATABASE_DSN apuntando a una base de datos desechable.
+type GormUserReadRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     *GormUserReadRepository
+	tenantID string
+}
+
+func (suite *GormUserReadRepositoryTestSuite) SetupSuite() {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		suite.T().Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.db = db
+	suite.repo = NewGormUserReadRepository(db)
+
+	migrator, err := migrations.NewMigrator(db)
+	suite.Require().NoError(err)
+	_, err = migrator.Up(context.Background())
+	suite.Require().NoError(err)
+}
+
+func (suite *GormUserReadRepositoryTestSuite) SetupTest() {
+	suite.tenantID = "read-repo-" + uuid.NewString()
+}
+
+func (suite *GormUserReadRepositoryTestSuite) TearDownTest() {
+	suite.db.Unscoped().Where("tenant_id = ?", suite.tenantID).Delete(&UserReadModel{})
+}
+
+func (suite *GormUserReadRepositoryTestSuite) upsert(user *entities.UserRead) {
+	ctx := shared_ports.WithTenantID(context.Background(), suite.tenantID)
+	err := transaction.NewGormTransactionManager(suite.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		return suite.repo.Upsert(ctx, user)
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *GormUserReadRepositoryTestSuite) TestOlderUpdateAfterNewerIsIgnored() {
+	id := uuid.New()
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	email := id.String() + "@example.com"
+
+	suite.upsert(entities.NewUserRead(id, suite.tenantID, "John Doe", email, nil, createdAt, createdAt))
+	suite.upsert(entities.NewUserRead(id, suite.tenantID, "Newer", email, nil, createdAt, createdAt.Add(2*time.Second)))
+
+	// Llega tarde, desde una cola de reintento
+	suite.upsert(entities.NewUserRead(id, suite.tenantID, "Older", email, nil, createdAt, createdAt.Add(time.Second)))
+
+	var model UserReadModel
+	suite.Require().NoError(suite.db.Where("id = ? AND tenant_id = ?", id, suite.tenantID).First(&model).Error)
+	suite.Equal("Newer", model.Name)
+	suite.True(model.UpdatedAt.Equal(createdAt.Add(2 * time.Second)))
+}
+
+func TestGormUserReadRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(GormUserReadRepositoryTestSuite))
+}
@@ -2,11 +2,14 @@ package persistence
 
 import (
 	"context"
+	"time"
 
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
 	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -27,11 +30,12 @@ func (r *GormUserRepository) Save(ctx context.Context, user *entities.User) erro
 		Email:       user.Email.Value(),
 		Password:    user.Password.Hash(),
 		DisplayName: user.DisplayName,
+		Role:        string(user.Role),
 		CreatedAt:   user.CreatedAt,
 		UpdatedAt:   user.UpdatedAt,
 	}
 
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+	if err := transaction.DB(ctx, r.db).Create(model).Error; err != nil {
 		if isDuplicateKeyError(err) {
 			return exceptions.ErrDuplicateEmail
 		}
@@ -41,10 +45,81 @@ func (r *GormUserRepository) Save(ctx context.Context, user *entities.User) erro
 	return nil
 }
 
+func (r *GormUserRepository) Update(ctx context.Context, user *entities.User) error {
+	result := transaction.DB(ctx, r.db).
+		Model(&UserModel{}).
+		Where("id = ? AND tenant_id = ?", user.ID, user.TenantID).
+		Updates(map[string]any{
+			"name":         user.Name,
+			"display_name": user.DisplayName,
+			"updated_at":   user.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return shared_exceptions.NewInternalServerError("failed to update user", result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return exceptions.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *GormUserRepository) SoftDelete(ctx context.Context, user *entities.User) error {
+	result := transaction.DB(ctx, r.db).
+		Model(&UserModel{}).
+		Where("id = ? AND tenant_id = ?", user.ID, user.TenantID).
+		Updates(map[string]any{
+			"deleted_at": user.DeletedAt,
+			"updated_at": user.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return shared_exceptions.NewInternalServerError("failed to delete user", result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return exceptions.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *GormUserRepository) Anonymize(ctx context.Context, user *entities.User) error {
+	result := transaction.DB(ctx, r.db).
+		Unscoped().
+		Model(&UserModel{}).
+		Where("id = ? AND tenant_id = ?", user.ID, user.TenantID).
+		Updates(map[string]any{
+			"name":         user.Name,
+			"email":        user.Email.Value(),
+			"password":     user.Password.Hash(),
+			"display_name": user.DisplayName,
+			"deleted_at":   user.DeletedAt,
+			"updated_at":   user.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return shared_exceptions.NewInternalServerError("failed to erase user", result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return exceptions.ErrUserNotFound
+	}
+
+	return nil
+}
+
 func (r *GormUserRepository) FindByID(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
+	return r.findByID(transaction.DB(ctx, r.db), tenantID, id)
+}
+
+func (r *GormUserRepository) FindByIDIncludingDeleted(ctx context.Context, tenantID string, id uuid.UUID) (*entities.User, error) {
+	return r.findByID(transaction.DB(ctx, r.db).Unscoped(), tenantID, id)
+}
+
+func (r *GormUserRepository) findByID(db *gorm.DB, tenantID string, id uuid.UUID) (*entities.User, error) {
 	var model UserModel
 
-	err := r.db.WithContext(ctx).
+	err := db.
 		Where("id = ? AND tenant_id = ?", id, tenantID).
 		First(&model).Error
 
@@ -61,7 +136,7 @@ func (r *GormUserRepository) FindByID(ctx context.Context, tenantID string, id u
 func (r *GormUserRepository) FindByEmail(ctx context.Context, tenantID string, email value_objects.Email) (*entities.User, error) {
 	var model UserModel
 
-	err := r.db.WithContext(ctx).
+	err := transaction.DB(ctx, r.db).
 		Where("email = ? AND tenant_id = ?", email.Value(), tenantID).
 		First(&model).Error
 
@@ -78,7 +153,7 @@ func (r *GormUserRepository) FindByEmail(ctx context.Context, tenantID string, e
 func (r *GormUserRepository) ExistsByEmail(ctx context.Context, tenantID string, email value_objects.Email) (bool, error) {
 	var count int64
 
-	err := r.db.WithContext(ctx).
+	err := transaction.DB(ctx, r.db).
 		Model(&UserModel{}).
 		Where("email = ? AND tenant_id = ?", email.Value(), tenantID).
 		Count(&count).Error
@@ -94,6 +169,11 @@ func (r *GormUserRepository) toDomain(model *UserModel) *entities.User {
 	email, _ := value_objects.NewEmail(model.Email)
 	password := value_objects.NewPasswordFromHash(model.Password)
 
+	var deletedAt *time.Time
+	if model.DeletedAt.Valid {
+		deletedAt = &model.DeletedAt.Time
+	}
+
 	return &entities.User{
 		ID:          model.ID,
 		TenantID:    model.TenantID,
@@ -101,8 +181,10 @@ func (r *GormUserRepository) toDomain(model *UserModel) *entities.User {
 		Email:       email,
 		Password:    password,
 		DisplayName: model.DisplayName,
+		Role:        authorization.Role(model.Role),
 		CreatedAt:   model.CreatedAt,
 		UpdatedAt:   model.UpdatedAt,
+		DeletedAt:   deletedAt,
 	}
 }
 
@@ -0,0 +1,255 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// rlsProbeRole es un rol sin BYPASSRLS al que cambian las transacciones del
+// test: si TEST_DATABASE_DSN conecta como superusuario, las políticas no se
+// aplicarían.
+const rlsProbeRole = "rls_probe"
+
+// tenantTables son las tablas con la política tenant_isolation.
+var tenantTables = []string{
+	"users", "users_read", "idempotency_keys",
+	"refresh_tokens", "event_store", "event_store_snapshots", "user_email_index",
+}
+
+// TenantIsolationTestSuite comprueba contra Postgres real que las políticas de
+// row-level security ocultan las filas de otros tenants aunque la consulta no
+// filtre por tenant_id. Necesita TEST_DATABASE_DSN apuntando a una base de
+// datos desechable.
+type TenantIsolationTestSuite struct {
+	suite.Suite
+	db        *gorm.DB
+	txManager *transaction.GormTransactionManager
+	tenantA   string
+	tenantB   string
+	userA     uuid.UUID
+	userB     uuid.UUID
+}
+
+func (suite *TenantIsolationTestSuite) SetupSuite() {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		suite.T().Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.db = db
+	suite.txManager = transaction.NewGormTransactionManager(db)
+
+	migrator, err := migrations.NewMigrator(db)
+	suite.Require().NoError(err)
+	_, err = migrator.Up(context.Background())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(db.Exec(`DO $$
+BEGIN
+    IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = '` + rlsProbeRole + `') THEN
+        CREATE ROLE ` + rlsProbeRole + ` NOLOGIN;
+    END IF;
+END $$`).Error)
+	suite.Require().NoError(db.Exec("GRANT " + rlsProbeRole + " TO CURRENT_USER").Error)
+	suite.Require().NoError(db.Exec("GRANT SELECT, INSERT, UPDATE, DELETE ON " + strings.Join(tenantTables, ", ") + " TO " + rlsProbeRole).Error)
+	suite.Require().NoError(db.Exec("GRANT USAGE ON SEQUENCE idempotency_keys_id_seq TO " + rlsProbeRole).Error)
+}
+
+func (suite *TenantIsolationTestSuite) SetupTest() {
+	// Tenants únicos por test para no chocar con datos de otras ejecuciones
+	suite.tenantA = "rls-a-" + uuid.NewString()
+	suite.tenantB = "rls-b-" + uuid.NewString()
+	suite.userA = uuid.New()
+	suite.userB = uuid.New()
+
+	now := time.Now().UTC()
+	for tenantID, id := range map[string]uuid.UUID{suite.tenantA: suite.userA, suite.tenantB: suite.userB} {
+		suite.Require().NoError(suite.db.Create(&UserModel{
+			ID:       id,
+			TenantID: tenantID,
+			Name:     "John Doe",
+			Email:    id.String() + "@example.com",
+			Password: "hashed",
+			Role:     "member",
+		}).Error)
+		suite.Require().NoError(suite.db.Create(&UserReadModel{
+			ID:        id,
+			TenantID:  tenantID,
+			Name:      "John Doe",
+			Email:     id.String() + "@example.com",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}).Error)
+		suite.Require().NoError(suite.db.Exec(
+			"INSERT INTO idempotency_keys (tenant_id, key, processed_at) VALUES (?, ?, ?)", tenantID, "key-1", now,
+		).Error)
+		suite.Require().NoError(suite.db.Exec(
+			"INSERT INTO refresh_tokens (id, tenant_id, user_id, family_id, token_hash, expires_at) VALUES (?, ?, ?, ?, ?, ?)",
+			uuid.New(), tenantID, id, uuid.New(), strings.Repeat(strings.ReplaceAll(id.String(), "-", ""), 2), now.Add(time.Hour),
+		).Error)
+		suite.Require().NoError(suite.db.Exec(
+			"INSERT INTO event_store (aggregate_type, aggregate_id, version, tenant_id, event_type, payload, occurred_at) VALUES (?, ?, 1, ?, ?, '{}', ?)",
+			"user", id.String(), tenantID, "user.created", now,
+		).Error)
+		suite.Require().NoError(suite.db.Exec(
+			"INSERT INTO event_store_snapshots (aggregate_type, aggregate_id, tenant_id, version, state) VALUES (?, ?, ?, 1, '{}')",
+			"user", id.String(), tenantID,
+		).Error)
+		suite.Require().NoError(suite.db.Exec(
+			"INSERT INTO user_email_index (tenant_id, email, user_id) VALUES (?, ?, ?)", tenantID, id.String()+"@example.com", id,
+		).Error)
+	}
+}
+
+func (suite *TenantIsolationTestSuite) TearDownTest() {
+	tenants := []string{suite.tenantA, suite.tenantB}
+	for _, table := range tenantTables {
+		suite.db.Exec("DELETE FROM "+table+" WHERE tenant_id IN ?", tenants)
+	}
+}
+
+// asTenant ejecuta fn en una transacción acotada a tenantID, como hace
+// TenantScopeMiddleware en cada petición HTTP.
+func (suite *TenantIsolationTestSuite) asTenant(tenantID string, fn func(ctx context.Context) error) error {
+	ctx := shared_ports.WithTenantID(context.Background(), tenantID)
+	return suite.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := transaction.DB(ctx, suite.db).Exec("SET LOCAL ROLE " + rlsProbeRole).Error; err != nil {
+			return err
+		}
+		return fn(ctx)
+	})
+}
+
+func (suite *TenantIsolationTestSuite) TestUnfilteredReadsOnlySeeOwnTenant() {
+	ids := []uuid.UUID{suite.userA, suite.userB}
+
+	err := suite.asTenant(suite.tenantA, func(ctx context.Context) error {
+		var users []UserModel
+		suite.Require().NoError(transaction.DB(ctx, suite.db).Where("id IN ?", ids).Find(&users).Error)
+		suite.Require().Len(users, 1)
+		suite.Equal(suite.userA, users[0].ID)
+
+		var read []UserReadModel
+		suite.Require().NoError(transaction.DB(ctx, suite.db).Where("id IN ?", ids).Find(&read).Error)
+		suite.Require().Len(read, 1)
+		suite.Equal(suite.tenantA, read[0].TenantID)
+
+		var keys int64
+		err := transaction.DB(ctx, suite.db).Raw(
+			"SELECT count(*) FROM idempotency_keys WHERE tenant_id IN ?", []string{suite.tenantA, suite.tenantB},
+		).Scan(&keys).Error
+		suite.Require().NoError(err)
+		suite.Equal(int64(1), keys)
+		return nil
+	})
+
+	suite.NoError(err)
+}
+
+func (suite *TenantIsolationTestSuite) TestEveryTenantTableOnlySeesOwnTenant() {
+	err := suite.asTenant(suite.tenantA, func(ctx context.Context) error {
+		for _, table := range tenantTables {
+			var tenants []string
+			err := transaction.DB(ctx, suite.db).Raw(
+				"SELECT DISTINCT tenant_id FROM "+table+" WHERE tenant_id IN ?", []string{suite.tenantA, suite.tenantB},
+			).Scan(&tenants).Error
+			suite.Require().NoError(err, table)
+			suite.Equal([]string{suite.tenantA}, tenants, table)
+		}
+		return nil
+	})
+
+	suite.NoError(err)
+}
+
+func (suite *TenantIsolationTestSuite) TestRepositoryWithWrongTenantFindsNothing() {
+	err := suite.asTenant(suite.tenantA, func(ctx context.Context) error {
+		// El repositorio recibe el tenant equivocado: la política lo ignora
+		_, err := NewGormUserReadRepository(suite.db).FindByID(ctx, suite.tenantB, suite.userB)
+		suite.ErrorIs(err, exceptions.ErrUserNotFound)
+
+		_, err = NewGormUserRepository(suite.db).FindByID(ctx, suite.tenantB, suite.userB)
+		suite.ErrorIs(err, exceptions.ErrUserNotFound)
+		return nil
+	})
+
+	suite.NoError(err)
+}
+
+func (suite *TenantIsolationTestSuite) TestCrossTenantWritesAreRejected() {
+	err := suite.asTenant(suite.tenantA, func(ctx context.Context) error {
+		result := transaction.DB(ctx, suite.db).
+			Model(&UserReadModel{}).
+			Where("id = ?", suite.userB).
+			Update("name", "Mallory")
+		suite.Require().NoError(result.Error)
+		suite.Equal(int64(0), result.RowsAffected)
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	err = suite.asTenant(suite.tenantA, func(ctx context.Context) error {
+		return transaction.DB(ctx, suite.db).Exec(
+			"INSERT INTO idempotency_keys (tenant_id, key, processed_at) VALUES (?, ?, now())", suite.tenantB, "key-2",
+		).Error
+	})
+	suite.ErrorContains(err, "row-level security")
+}
+
+// withoutTenant ejecuta fn en una transacción sin app.tenant_id, como lo que
+// por error corriera fuera de TenantScopeMiddleware.
+func (suite *TenantIsolationTestSuite) withoutTenant(fn func(tx *gorm.DB) error) error {
+	return suite.txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		tx := transaction.DB(ctx, suite.db)
+		if err := tx.Exec("SET LOCAL ROLE " + rlsProbeRole).Error; err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+func (suite *TenantIsolationTestSuite) TestWithoutTenantSeesNothing() {
+	var count int64
+	err := suite.withoutTenant(func(tx *gorm.DB) error {
+		return tx.Model(&UserReadModel{}).
+			Where("tenant_id IN ?", []string{suite.tenantA, suite.tenantB}).
+			Count(&count).Error
+	})
+
+	suite.NoError(err)
+	suite.Equal(int64(0), count)
+}
+
+func (suite *TenantIsolationTestSuite) TestWithoutTenantWritesAreRejected() {
+	err := suite.withoutTenant(func(tx *gorm.DB) error {
+		return tx.Exec(
+			"INSERT INTO idempotency_keys (tenant_id, key, processed_at) VALUES (?, ?, now())", suite.tenantA, "key-2",
+		).Error
+	})
+	suite.ErrorContains(err, "row-level security")
+
+	err = suite.withoutTenant(func(tx *gorm.DB) error {
+		result := tx.Model(&UserModel{}).Where("id = ?", suite.userA).Update("name", "Mallory")
+		suite.Equal(int64(0), result.RowsAffected)
+		return result.Error
+	})
+	suite.NoError(err)
+}
+
+func TestTenantIsolationTestSuite(t *testing.T) {
+	suite.Run(t, new(TenantIsolationTestSuite))
+}
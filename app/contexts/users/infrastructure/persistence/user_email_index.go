@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/exceptions"
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UserEmailIndexModel permite buscar usuarios event-sourced por email y
+// garantiza la unicidad del email por tenant, algo que el stream no puede.
+type UserEmailIndexModel struct {
+	TenantID string    `gorm:"type:varchar(100);primaryKey"`
+	Email    string    `gorm:"type:varchar(255);primaryKey"`
+	UserID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_user_email_index_user"`
+}
+
+func (UserEmailIndexModel) TableName() string {
+	return "user_email_index"
+}
+
+type userEmailIndex interface {
+	Reserve(ctx context.Context, tenantID, email string, userID uuid.UUID) error
+	Replace(ctx context.Context, tenantID string, userID uuid.UUID, email string) error
+	Lookup(ctx context.Context, tenantID, email string) (uuid.UUID, bool, error)
+}
+
+type gormUserEmailIndex struct {
+	db *gorm.DB
+}
+
+func (i *gormUserEmailIndex) Reserve(ctx context.Context, tenantID, email string, userID uuid.UUID) error {
+	model := &UserEmailIndexModel{TenantID: tenantID, Email: email, UserID: userID}
+
+	if err := transaction.DB(ctx, i.db).Create(model).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return exceptions.ErrDuplicateEmail
+		}
+		return shared_exceptions.NewInternalServerError("failed to reserve email", err.Error())
+	}
+
+	return nil
+}
+
+func (i *gormUserEmailIndex) Replace(ctx context.Context, tenantID string, userID uuid.UUID, email string) error {
+	err := transaction.DB(ctx, i.db).
+		Model(&UserEmailIndexModel{}).
+		Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Update("email", email).Error
+	if err != nil {
+		return shared_exceptions.NewInternalServerError("failed to update email index", err.Error())
+	}
+
+	return nil
+}
+
+func (i *gormUserEmailIndex) Lookup(ctx context.Context, tenantID, email string) (uuid.UUID, bool, error) {
+	var model UserEmailIndexModel
+
+	err := transaction.DB(ctx, i.db).
+		Where("tenant_id = ? AND email = ?", tenantID, email).
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return uuid.Nil, false, nil
+	}
+	if err != nil {
+		return uuid.Nil, false, shared_exceptions.NewInternalServerError("failed to look up email", err.Error())
+	}
+
+	return model.UserID, true, nil
+}
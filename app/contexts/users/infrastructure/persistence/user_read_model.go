@@ -1,16 +1,23 @@
 package persistence
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// UserReadModel toma las fechas de los eventos, por eso desactiva el relleno
+// automático de CreatedAt y UpdatedAt que hace GORM.
 type UserReadModel struct {
-	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
-	TenantID    string    `gorm:"type:varchar(100);primaryKey;index:idx_read_tenant"`
-	Name        string    `gorm:"type:varchar(255);not null"`
-	Email       string    `gorm:"type:varchar(255);not null"`
-	DisplayName *string   `gorm:"type:varchar(255)"`
-	CreatedAt   string    `gorm:"type:varchar(50);not null"`
+	ID          uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	TenantID    string         `gorm:"type:varchar(100);primaryKey;index:idx_read_tenant;index:idx_read_tenant_created,priority:1"`
+	Name        string         `gorm:"type:varchar(255);not null"`
+	Email       string         `gorm:"type:varchar(255);not null"`
+	DisplayName *string        `gorm:"type:varchar(255)"`
+	CreatedAt   time.Time      `gorm:"type:timestamptz;not null;autoCreateTime:false;index:idx_read_tenant_created,priority:2"`
+	UpdatedAt   time.Time      `gorm:"type:timestamptz;not null;autoUpdateTime:false"`
+	DeletedAt   gorm.DeletedAt `gorm:"index:idx_read_deleted_at"`
 }
 
 func (UserReadModel) TableName() string {
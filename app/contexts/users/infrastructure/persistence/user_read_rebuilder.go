@@ -0,0 +1,277 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/eventstore"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const userReadShadowTable = "users_read_rebuild"
+
+// Claves de advisory lock. userReadRebuildLock impide dos reconstrucciones a
+// la vez; userReadWriteLock, por tenant, lo toman compartido los proyectores
+// en cada escritura y exclusivo la reconstrucción.
+const (
+	userReadRebuildLock = "users_read_rebuild"
+	userReadWriteLock   = "users_read"
+)
+
+var ErrRebuildInProgress = errors.New("another rebuild of users_read is running")
+
+// RebuildProgress se notifica tras cada lote. Total es una estimación: se
+// cuenta antes del escaneo.
+type RebuildProgress struct {
+	Processed int64
+	Written   int64
+	Total     int64
+}
+
+// userReadSource recorre el write model por lotes ordenados por ID. next
+// devuelve las filas de la proyección y el último ID visto, aunque el lote no
+// produzca filas. Los usuarios borrados se devuelven con su DeletedAt, como
+// los deja el proyector de user.deleted.
+type userReadSource interface {
+	count(ctx context.Context, tenantID string) (int64, error)
+	next(ctx context.Context, tenantID string, after uuid.UUID, limit int) ([]UserReadModel, uuid.UUID, int, error)
+}
+
+// UserReadRebuilder regenera users_read desde el write model. Construye la
+// proyección en una tabla temporal y la vuelca sobre users_read en la misma
+// transacción, de modo que las lecturas siguen viendo los datos anteriores
+// hasta el commit. Con tenantID solo se reemplazan las filas de ese tenant.
+//
+// Mientras dura, los proyectores del alcance reconstruido esperan (ver
+// lockUserReadForWrite): lo que proyecten se aplica después del volcado en
+// lugar de quedar borrado o pisado por él.
+type UserReadRebuilder struct {
+	db        *gorm.DB
+	source    userReadSource
+	batchSize int
+}
+
+// NewStateUserReadRebuilder lee los usuarios de la tabla users.
+func NewStateUserReadRebuilder(db *gorm.DB, batchSize int) *UserReadRebuilder {
+	return &UserReadRebuilder{db: db, source: &stateUserReadSource{db: db}, batchSize: batchSize}
+}
+
+// NewEventSourcedUserReadRebuilder reconstruye cada usuario a partir de su
+// stream en el event store.
+func NewEventSourcedUserReadRebuilder(db *gorm.DB, repository *EventSourcedUserRepository, batchSize int) *UserReadRebuilder {
+	return &UserReadRebuilder{
+		db:        db,
+		source:    &eventSourcedUserReadSource{db: db, repository: repository},
+		batchSize: batchSize,
+	}
+}
+
+func (r *UserReadRebuilder) Rebuild(ctx context.Context, tenantID string, progress func(RebuildProgress)) error {
+	// Todo corre en una transacción: los locks y la tabla temporal se liberan
+	// con ella aunque el proceso muera a medias
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var acquired bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(hashtext(?))", userReadRebuildLock).Scan(&acquired).Error; err != nil {
+			return fmt.Errorf("failed to acquire rebuild lock: %w", err)
+		}
+		if !acquired {
+			return ErrRebuildInProgress
+		}
+
+		// Con tenantID vacío se pausa a los proyectores de todos los tenants
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?), hashtext(?))", userReadWriteLock, tenantID).Error; err != nil {
+			return fmt.Errorf("failed to pause projections: %w", err)
+		}
+
+		// La tabla sombra copia el esquema actual de users_read, así que recoge
+		// las columnas que haya añadido una migración
+		if err := tx.Exec("CREATE TEMPORARY TABLE " + userReadShadowTable + " (LIKE users_read INCLUDING DEFAULTS) ON COMMIT DROP").Error; err != nil {
+			return fmt.Errorf("failed to create shadow table: %w", err)
+		}
+
+		if err := r.scan(ctx, tx, tenantID, progress); err != nil {
+			return err
+		}
+
+		return r.swap(tx, tenantID)
+	})
+}
+
+func (r *UserReadRebuilder) scan(ctx context.Context, tx *gorm.DB, tenantID string, progress func(RebuildProgress)) error {
+	total, err := r.source.count(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	state := RebuildProgress{Total: total}
+	after := uuid.Nil
+
+	for {
+		rows, last, seen, err := r.source.next(ctx, tenantID, after, r.batchSize)
+		if err != nil {
+			return err
+		}
+		if seen == 0 {
+			return nil
+		}
+
+		if len(rows) > 0 {
+			if err := tx.Table(userReadShadowTable).Create(&rows).Error; err != nil {
+				return fmt.Errorf("failed to write shadow rows: %w", err)
+			}
+		}
+
+		after = last
+		state.Processed += int64(seen)
+		state.Written += int64(len(rows))
+		if progress != nil {
+			progress(state)
+		}
+	}
+}
+
+func (r *UserReadRebuilder) swap(tx *gorm.DB, tenantID string) error {
+	deleteQuery := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Unscoped()
+	if tenantID != "" {
+		deleteQuery = deleteQuery.Where("tenant_id = ?", tenantID)
+	}
+	if err := deleteQuery.Delete(&UserReadModel{}).Error; err != nil {
+		return fmt.Errorf("failed to clear users_read: %w", err)
+	}
+
+	if err := tx.Exec("INSERT INTO users_read SELECT * FROM " + userReadShadowTable).Error; err != nil {
+		return fmt.Errorf("failed to swap users_read: %w", err)
+	}
+
+	return nil
+}
+
+// lockUserReadForWrite espera a que termine cualquier reconstrucción que
+// cubra a tenantID, sea de ese tenant o de todos. El lock es de transacción:
+// se suelta con el commit del proyector.
+func lockUserReadForWrite(db *gorm.DB, tenantID string) error {
+	return db.Exec(
+		"SELECT pg_advisory_xact_lock_shared(hashtext(?), hashtext('')), pg_advisory_xact_lock_shared(hashtext(?), hashtext(?))",
+		userReadWriteLock, userReadWriteLock, tenantID,
+	).Error
+}
+
+type stateUserReadSource struct {
+	db *gorm.DB
+}
+
+// query incluye los usuarios borrados: su fila de users_read conserva el
+// deleted_at.
+func (s *stateUserReadSource) query(ctx context.Context, tenantID string) *gorm.DB {
+	query := s.db.WithContext(ctx).Unscoped().Model(&UserModel{})
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	return query
+}
+
+func (s *stateUserReadSource) count(ctx context.Context, tenantID string) (int64, error) {
+	var total int64
+	if err := s.query(ctx, tenantID).Count(&total).Error; err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+	return total, nil
+}
+
+func (s *stateUserReadSource) next(ctx context.Context, tenantID string, after uuid.UUID, limit int) ([]UserReadModel, uuid.UUID, int, error) {
+	var models []UserModel
+	err := s.query(ctx, tenantID).
+		Where("id > ?", after).
+		Order("id").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, after, 0, fmt.Errorf("failed to read users: %w", err)
+	}
+
+	rows := make([]UserReadModel, 0, len(models))
+	for _, model := range models {
+		rows = append(rows, UserReadModel{
+			ID:          model.ID,
+			TenantID:    model.TenantID,
+			Name:        model.Name,
+			Email:       model.Email,
+			DisplayName: model.DisplayName,
+			CreatedAt:   model.CreatedAt,
+			UpdatedAt:   model.UpdatedAt,
+			DeletedAt:   model.DeletedAt,
+		})
+		after = model.ID
+	}
+
+	return rows, after, len(models), nil
+}
+
+type eventSourcedUserReadSource struct {
+	db         *gorm.DB
+	repository *EventSourcedUserRepository
+}
+
+// query selecciona el primer evento de cada stream de usuario: hay exactamente
+// uno por agregado.
+func (s *eventSourcedUserReadSource) query(ctx context.Context, tenantID string) *gorm.DB {
+	query := s.db.WithContext(ctx).
+		Model(&eventstore.StoredEventModel{}).
+		Where("aggregate_type = ? AND version = 1", userAggregateType)
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	return query
+}
+
+func (s *eventSourcedUserReadSource) count(ctx context.Context, tenantID string) (int64, error) {
+	var total int64
+	if err := s.query(ctx, tenantID).Count(&total).Error; err != nil {
+		return 0, fmt.Errorf("failed to count user streams: %w", err)
+	}
+	return total, nil
+}
+
+func (s *eventSourcedUserReadSource) next(ctx context.Context, tenantID string, after uuid.UUID, limit int) ([]UserReadModel, uuid.UUID, int, error) {
+	var heads []eventstore.StoredEventModel
+	err := s.query(ctx, tenantID).
+		Where("aggregate_id > ?", after.String()).
+		Order("aggregate_id").
+		Limit(limit).
+		Find(&heads).Error
+	if err != nil {
+		return nil, after, 0, fmt.Errorf("failed to read user streams: %w", err)
+	}
+
+	rows := make([]UserReadModel, 0, len(heads))
+	for _, head := range heads {
+		id, err := uuid.Parse(head.AggregateID)
+		if err != nil {
+			return nil, after, 0, fmt.Errorf("invalid user stream %s: %w", head.AggregateID, err)
+		}
+		after = id
+
+		user, err := s.repository.FindByIDIncludingDeleted(ctx, head.TenantID, id)
+		if err != nil {
+			return nil, after, 0, err
+		}
+
+		row := UserReadModel{
+			ID:          user.ID,
+			TenantID:    user.TenantID,
+			Name:        user.Name,
+			Email:       user.Email.Value(),
+			DisplayName: user.DisplayName,
+			CreatedAt:   user.CreatedAt,
+			UpdatedAt:   user.UpdatedAt,
+		}
+		if user.IsDeleted() {
+			row.DeletedAt = gorm.DeletedAt{Time: *user.DeletedAt, Valid: true}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, after, len(heads), nil
+}
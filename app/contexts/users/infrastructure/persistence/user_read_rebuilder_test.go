@@ -0,0 +1,255 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/eventstore"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// UserReadRebuilderTestSuite regenera users_read contra Postgres real desde la
+// tabla users. Necesita TEST_DATABASE_DSN apuntando a una base de datos
+// desechable.
+type UserReadRebuilderTestSuite struct {
+	suite.Suite
+	db      *gorm.DB
+	tenantA string
+	tenantB string
+	usersA  []uuid.UUID
+	userB   uuid.UUID
+}
+
+func (suite *UserReadRebuilderTestSuite) SetupSuite() {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		suite.T().Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.db = db
+
+	migrator, err := migrations.NewMigrator(db)
+	suite.Require().NoError(err)
+	_, err = migrator.Up(context.Background())
+	suite.Require().NoError(err)
+}
+
+func (suite *UserReadRebuilderTestSuite) SetupTest() {
+	suite.tenantA = "rebuild-a-" + uuid.NewString()
+	suite.tenantB = "rebuild-b-" + uuid.NewString()
+	suite.usersA = []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	suite.userB = uuid.New()
+
+	for _, id := range suite.usersA {
+		suite.createUser(suite.tenantA, id, "John Doe")
+	}
+	suite.createUser(suite.tenantB, suite.userB, "Jane Roe")
+}
+
+func (suite *UserReadRebuilderTestSuite) TearDownTest() {
+	tenants := []string{suite.tenantA, suite.tenantB}
+	suite.db.Unscoped().Where("tenant_id IN ?", tenants).Delete(&UserModel{})
+	suite.db.Unscoped().Where("tenant_id IN ?", tenants).Delete(&UserReadModel{})
+	suite.db.Where("tenant_id IN ?", tenants).Delete(&eventstore.StoredEventModel{})
+	suite.db.Where("tenant_id IN ?", tenants).Delete(&eventstore.SnapshotModel{})
+	suite.db.Exec("DELETE FROM user_email_index WHERE tenant_id IN ?", tenants)
+}
+
+func (suite *UserReadRebuilderTestSuite) createUser(tenantID string, id uuid.UUID, name string) {
+	suite.Require().NoError(suite.db.Create(&UserModel{
+		ID:       id,
+		TenantID: tenantID,
+		Name:     name,
+		Email:    id.String() + "@example.com",
+		Password: "hashed",
+		Role:     "member",
+	}).Error)
+}
+
+func (suite *UserReadRebuilderTestSuite) createReadRow(tenantID string, id uuid.UUID, name string) {
+	now := time.Now().UTC()
+	suite.Require().NoError(suite.db.Create(&UserReadModel{
+		ID:        id,
+		TenantID:  tenantID,
+		Name:      name,
+		Email:     id.String() + "@example.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}).Error)
+}
+
+func (suite *UserReadRebuilderTestSuite) readRows(tenantID string) map[uuid.UUID]UserReadModel {
+	var models []UserReadModel
+	suite.Require().NoError(suite.db.Where("tenant_id = ?", tenantID).Find(&models).Error)
+
+	rows := make(map[uuid.UUID]UserReadModel, len(models))
+	for _, model := range models {
+		rows[model.ID] = model
+	}
+	return rows
+}
+
+func (suite *UserReadRebuilderTestSuite) TestRebuildScansInBatchesAndReplacesRows() {
+	// Una fila desactualizada y otra de un usuario que ya no existe
+	suite.createReadRow(suite.tenantA, suite.usersA[0], "Outdated")
+	stale := uuid.New()
+	suite.createReadRow(suite.tenantA, stale, "Stale")
+
+	var reports []RebuildProgress
+	err := NewStateUserReadRebuilder(suite.db, 2).Rebuild(context.Background(), suite.tenantA, func(progress RebuildProgress) {
+		reports = append(reports, progress)
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(reports, 2)
+	suite.Equal(RebuildProgress{Processed: 3, Written: 3, Total: 3}, reports[1])
+
+	rows := suite.readRows(suite.tenantA)
+	suite.Len(rows, 3)
+	suite.Equal("John Doe", rows[suite.usersA[0]].Name)
+	suite.NotContains(rows, stale)
+}
+
+func (suite *UserReadRebuilderTestSuite) deletedReadRow(tenantID string, id uuid.UUID) UserReadModel {
+	var model UserReadModel
+	suite.Require().NoError(suite.db.Unscoped().Where("tenant_id = ? AND id = ?", tenantID, id).First(&model).Error)
+	return model
+}
+
+func (suite *UserReadRebuilderTestSuite) TestRebuildKeepsSoftDeletedUsers() {
+	deleted := suite.usersA[1]
+	suite.Require().NoError(suite.db.Where("id = ?", deleted).Delete(&UserModel{}).Error)
+
+	var user UserModel
+	suite.Require().NoError(suite.db.Unscoped().Where("id = ?", deleted).First(&user).Error)
+
+	err := NewStateUserReadRebuilder(suite.db, 10).Rebuild(context.Background(), suite.tenantA, nil)
+	suite.Require().NoError(err)
+
+	// No aparece en las consultas, pero la fila sigue con su deleted_at
+	suite.NotContains(suite.readRows(suite.tenantA), deleted)
+	row := suite.deletedReadRow(suite.tenantA, deleted)
+	suite.True(row.DeletedAt.Valid)
+	suite.WithinDuration(user.DeletedAt.Time, row.DeletedAt.Time, time.Millisecond)
+}
+
+func (suite *UserReadRebuilderTestSuite) TestEventSourcedRebuildKeepsDeletedUsers() {
+	ctx := shared_ports.WithTenantID(context.Background(), suite.tenantA)
+	repository := NewEventSourcedUserRepository(suite.db, eventstore.NewGormEventStore(suite.db), 10)
+
+	email, err := value_objects.NewEmail(uuid.NewString() + "@example.com")
+	suite.Require().NoError(err)
+	user, err := entities.NewUser(suite.tenantA, "John Doe", email, value_objects.NewPasswordFromHash("hashed"), nil)
+	suite.Require().NoError(err)
+
+	err = transaction.NewGormTransactionManager(suite.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := repository.Save(ctx, user); err != nil {
+			return err
+		}
+		user.Delete()
+		return repository.SoftDelete(ctx, user)
+	})
+	suite.Require().NoError(err)
+
+	err = NewEventSourcedUserReadRebuilder(suite.db, repository, 10).Rebuild(context.Background(), suite.tenantA, nil)
+	suite.Require().NoError(err)
+
+	row := suite.deletedReadRow(suite.tenantA, user.ID)
+	suite.True(row.DeletedAt.Valid)
+	suite.WithinDuration(*user.DeletedAt, row.DeletedAt.Time, time.Millisecond)
+}
+
+func (suite *UserReadRebuilderTestSuite) TestRebuildForTenantLeavesOtherTenants() {
+	stale := uuid.New()
+	suite.createReadRow(suite.tenantB, stale, "Stale")
+
+	err := NewStateUserReadRebuilder(suite.db, 10).Rebuild(context.Background(), suite.tenantA, nil)
+	suite.Require().NoError(err)
+
+	suite.Len(suite.readRows(suite.tenantA), 3)
+
+	// El tenant B no se ha reconstruido: conserva su fila y no gana la de users
+	rowsB := suite.readRows(suite.tenantB)
+	suite.Len(rowsB, 1)
+	suite.Contains(rowsB, stale)
+}
+
+func (suite *UserReadRebuilderTestSuite) TestRebuildWithoutTenantCoversEveryTenant() {
+	err := NewStateUserReadRebuilder(suite.db, 10).Rebuild(context.Background(), "", nil)
+	suite.Require().NoError(err)
+
+	suite.Len(suite.readRows(suite.tenantA), 3)
+	suite.Contains(suite.readRows(suite.tenantB), suite.userB)
+}
+
+func (suite *UserReadRebuilderTestSuite) TestWriteDuringRebuildIsAppliedAfterSwap() {
+	ctx := context.Background()
+	written := uuid.New()
+	done := make(chan error, 1)
+	started := false
+
+	err := NewStateUserReadRebuilder(suite.db, 1).Rebuild(ctx, suite.tenantA, func(progress RebuildProgress) {
+		if started {
+			return
+		}
+		started = true
+
+		// El proyector escribe a mitad de la reconstrucción, como haría el
+		// consumer con un usuario creado después del escaneo
+		go func() {
+			txManager := transaction.NewGormTransactionManager(suite.db)
+			tenantCtx := shared_ports.WithTenantID(ctx, suite.tenantA)
+			done <- txManager.WithinTransaction(tenantCtx, func(ctx context.Context) error {
+				now := time.Now().UTC()
+				return NewGormUserReadRepository(suite.db).Upsert(ctx, entities.NewUserRead(
+					written, suite.tenantA, "Written", written.String()+"@example.com", nil, now, now,
+				))
+			})
+		}()
+
+		select {
+		case err := <-done:
+			suite.Failf("projection was not paused", "write finished during the rebuild: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+	suite.Require().NoError(err)
+
+	select {
+	case err := <-done:
+		suite.Require().NoError(err)
+	case <-time.After(5 * time.Second):
+		suite.FailNow("projection still blocked after the rebuild")
+	}
+
+	rows := suite.readRows(suite.tenantA)
+	suite.Len(rows, 4)
+	suite.Contains(rows, written)
+}
+
+func (suite *UserReadRebuilderTestSuite) TestConcurrentRebuildIsRejected() {
+	err := suite.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", userReadRebuildLock).Error; err != nil {
+			return err
+		}
+		return NewStateUserReadRebuilder(suite.db, 10).Rebuild(context.Background(), suite.tenantB, nil)
+	})
+
+	suite.ErrorIs(err, ErrRebuildInProgress)
+}
+
+func TestUserReadRebuilderTestSuite(t *testing.T) {
+	suite.Run(t, new(UserReadRebuilderTestSuite))
+}
@@ -0,0 +1,260 @@
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/entities"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/domain/value_objects"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/google/uuid"
+)
+
+// Eventos del stream de User. A diferencia de los eventos de integración que
+// se publican en el broker, llevan todo el estado necesario para reconstruir
+// el agregado (incluido el hash de la contraseña) y nunca salen de la base de
+// datos.
+const (
+	userAggregateType = "user"
+
+	userRegisteredEvent     = "UserRegistered"
+	userProfileChangedEvent = "UserProfileChanged"
+	userDeletedEvent        = "UserDeleted"
+	userErasedEvent         = "UserErased"
+)
+
+type userRegistered struct {
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"password_hash"`
+	DisplayName  *string   `json:"display_name,omitempty"`
+	Role         string    `json:"role"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type userProfileChanged struct {
+	Name        string    `json:"name"`
+	DisplayName *string   `json:"display_name,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type userDeleted struct {
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+type userErased struct {
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	DeletedAt time.Time `json:"deleted_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// userSnapshot es el estado completo del agregado tras aplicar un evento.
+type userSnapshot struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     string     `json:"tenant_id"`
+	Name         string     `json:"name"`
+	Email        string     `json:"email"`
+	PasswordHash string     `json:"password_hash"`
+	DisplayName  *string    `json:"display_name,omitempty"`
+	Role         string     `json:"role"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+}
+
+func newUserStreamEvent(user *entities.User, eventType string, payload any) (ports.StreamEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return ports.StreamEvent{}, err
+	}
+
+	return ports.StreamEvent{
+		AggregateID: user.ID.String(),
+		TenantID:    user.TenantID,
+		Type:        eventType,
+		Payload:     data,
+		OccurredAt:  time.Now(),
+	}, nil
+}
+
+// replayUser reconstruye el usuario partiendo del snapshot (si lo hay) y
+// aplicando en orden los eventos posteriores. Devuelve nil si no hay nada que
+// aplicar.
+func replayUser(snapshot *ports.Snapshot, events []ports.StreamEvent) (*entities.User, error) {
+	var user *entities.User
+
+	if snapshot != nil {
+		restored, err := restoreUserSnapshot(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		user = restored
+	}
+
+	for _, event := range events {
+		if user == nil {
+			if event.Type != userRegisteredEvent {
+				return nil, fmt.Errorf("stream must start with %s, got %s", userRegisteredEvent, event.Type)
+			}
+			id, err := uuid.Parse(event.AggregateID)
+			if err != nil {
+				return nil, err
+			}
+			user = &entities.User{ID: id, TenantID: event.TenantID}
+		}
+
+		if err := applyUserEvent(user, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func applyUserEvent(user *entities.User, event ports.StreamEvent) error {
+	switch event.Type {
+	case userRegisteredEvent:
+		var payload userRegistered
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return err
+		}
+		email, err := value_objects.NewEmail(payload.Email)
+		if err != nil {
+			return err
+		}
+		user.Name = payload.Name
+		user.Email = email
+		user.Password = value_objects.NewPasswordFromHash(payload.PasswordHash)
+		user.DisplayName = payload.DisplayName
+		user.Role = authorization.Role(payload.Role)
+		user.CreatedAt = payload.CreatedAt
+		user.UpdatedAt = payload.CreatedAt
+
+	case userProfileChangedEvent:
+		var payload userProfileChanged
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return err
+		}
+		user.Name = payload.Name
+		user.DisplayName = payload.DisplayName
+		user.UpdatedAt = payload.UpdatedAt
+
+	case userDeletedEvent:
+		var payload userDeleted
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return err
+		}
+		user.DeletedAt = &payload.DeletedAt
+		user.UpdatedAt = payload.DeletedAt
+
+	case userErasedEvent:
+		var payload userErased
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return err
+		}
+		email, err := value_objects.NewEmail(payload.Email)
+		if err != nil {
+			return err
+		}
+		user.Name = payload.Name
+		user.Email = email
+		user.Password = value_objects.NewPasswordFromHash("")
+		user.DisplayName = nil
+		user.DeletedAt = &payload.DeletedAt
+		user.UpdatedAt = payload.UpdatedAt
+
+	default:
+		return fmt.Errorf("unknown user stream event %s", event.Type)
+	}
+
+	user.Version = event.Version
+	return nil
+}
+
+// redactUserEvent devuelve el payload del evento con los datos personales
+// sustituidos por los del usuario ya anonimizado. El stream sigue pudiendo
+// reproducirse y termina en el mismo estado.
+func redactUserEvent(user *entities.User, event ports.StreamEvent) ([]byte, error) {
+	switch event.Type {
+	case userRegisteredEvent:
+		var payload userRegistered
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, err
+		}
+		payload.Name = user.Name
+		payload.Email = user.Email.Value()
+		payload.PasswordHash = ""
+		payload.DisplayName = nil
+		return json.Marshal(payload)
+
+	case userProfileChangedEvent:
+		var payload userProfileChanged
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, err
+		}
+		payload.Name = user.Name
+		payload.DisplayName = nil
+		return json.Marshal(payload)
+
+	case userDeletedEvent, userErasedEvent:
+		// No llevan datos personales, o ya están anonimizados
+		return event.Payload, nil
+
+	default:
+		return nil, fmt.Errorf("unknown user stream event %s", event.Type)
+	}
+}
+
+func takeUserSnapshot(user *entities.User) (ports.Snapshot, error) {
+	state, err := json.Marshal(userSnapshot{
+		ID:           user.ID,
+		TenantID:     user.TenantID,
+		Name:         user.Name,
+		Email:        user.Email.Value(),
+		PasswordHash: user.Password.Hash(),
+		DisplayName:  user.DisplayName,
+		Role:         string(user.Role),
+		CreatedAt:    user.CreatedAt,
+		UpdatedAt:    user.UpdatedAt,
+		DeletedAt:    user.DeletedAt,
+	})
+	if err != nil {
+		return ports.Snapshot{}, err
+	}
+
+	return ports.Snapshot{
+		AggregateID: user.ID.String(),
+		TenantID:    user.TenantID,
+		Version:     user.Version,
+		State:       state,
+	}, nil
+}
+
+func restoreUserSnapshot(snapshot *ports.Snapshot) (*entities.User, error) {
+	var state userSnapshot
+	if err := json.Unmarshal(snapshot.State, &state); err != nil {
+		return nil, err
+	}
+
+	email, err := value_objects.NewEmail(state.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.User{
+		ID:          state.ID,
+		TenantID:    state.TenantID,
+		Name:        state.Name,
+		Email:       email,
+		Password:    value_objects.NewPasswordFromHash(state.PasswordHash),
+		DisplayName: state.DisplayName,
+		Role:        authorization.Role(state.Role),
+		CreatedAt:   state.CreatedAt,
+		UpdatedAt:   state.UpdatedAt,
+		DeletedAt:   state.DeletedAt,
+		Version:     snapshot.Version,
+	}, nil
+}
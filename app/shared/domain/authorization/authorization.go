@@ -0,0 +1,72 @@
+package authorization
+
+type Role string
+
+const (
+	// RolePlatformAdmin administra tenants y feature flags; se asigna a los
+	// operadores del tenant platform.
+	RolePlatformAdmin Role = "platform_admin"
+	RoleTenantAdmin   Role = "tenant_admin"
+	RoleMember        Role = "member"
+)
+
+type Permission string
+
+const (
+	PermissionUsersRead    Permission = "users:read"
+	PermissionUsersReadAny Permission = "users:read:any"
+	PermissionUsersList    Permission = "users:list"
+	PermissionUsersUpdate  Permission = "users:update"
+	PermissionUsersDelete  Permission = "users:delete"
+
+	PermissionTenantsRead   Permission = "tenants:read"
+	PermissionTenantsManage Permission = "tenants:manage"
+
+	PermissionFeatureFlagsRead   Permission = "feature_flags:read"
+	PermissionFeatureFlagsManage Permission = "feature_flags:manage"
+)
+
+// rolePermissions es la matriz de permisos; un rol desconocido no tiene ninguno.
+var rolePermissions = map[Role][]Permission{
+	RolePlatformAdmin: {
+		PermissionTenantsRead,
+		PermissionTenantsManage,
+		PermissionFeatureFlagsRead,
+		PermissionFeatureFlagsManage,
+	},
+	RoleTenantAdmin: {
+		PermissionUsersRead,
+		PermissionUsersReadAny,
+		PermissionUsersList,
+		PermissionUsersUpdate,
+		PermissionUsersDelete,
+	},
+	RoleMember: {
+		PermissionUsersRead,
+	},
+}
+
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Principal es quien ejecuta la operación, tal como lo autenticó el token.
+type Principal struct {
+	UserID   string
+	TenantID string
+	Role     Role
+}
+
+func (p Principal) Can(permission Permission) bool {
+	return p.Role.Can(permission)
+}
@@ -6,19 +6,24 @@ import (
 	"github.com/google/uuid"
 )
 
+// BaseEvent es el sobre común de los eventos de dominio. EventVersionValue
+// identifica el esquema del payload; los eventos anteriores al versionado no
+// lo traen y se leen como versión 1.
 type BaseEvent struct {
-	EventIDValue     string    `json:"event_id"`
-	EventTypeValue   string    `json:"event_type"`
-	AggregateIDValue string    `json:"aggregate_id"`
-	OccurredOnValue  time.Time `json:"occurred_on"`
+	EventIDValue      string    `json:"event_id"`
+	EventTypeValue    string    `json:"event_type"`
+	EventVersionValue int       `json:"event_version"`
+	AggregateIDValue  string    `json:"aggregate_id"`
+	OccurredOnValue   time.Time `json:"occurred_on"`
 }
 
-func NewBaseEvent(eventType, aggregateID string) BaseEvent {
+func NewBaseEvent(eventType string, version int, aggregateID string) BaseEvent {
 	return BaseEvent{
-		EventIDValue:     uuid.New().String(),
-		EventTypeValue:   eventType,
-		AggregateIDValue: aggregateID,
-		OccurredOnValue:  time.Now(),
+		EventIDValue:      uuid.New().String(),
+		EventTypeValue:    eventType,
+		EventVersionValue: version,
+		AggregateIDValue:  aggregateID,
+		OccurredOnValue:   time.Now(),
 	}
 }
 
@@ -30,6 +35,10 @@ func (e BaseEvent) EventType() string {
 	return e.EventTypeValue
 }
 
+func (e BaseEvent) EventVersion() int {
+	return e.EventVersionValue
+}
+
 func (e BaseEvent) AggregateID() string {
 	return e.AggregateIDValue
 }
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Upcaster lleva el payload (campo data) de un evento de la versión N a la N+1.
+type Upcaster func(data map[string]any) error
+
+// UpcasterChain agrupa los upcasters de un tipo de evento por versión de
+// origen. Upcast los aplica en cadena hasta la versión más reciente.
+type UpcasterChain map[int]Upcaster
+
+func (c UpcasterChain) Upcast(raw []byte) ([]byte, error) {
+	var envelope map[string]any
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, err
+	}
+
+	version := 1
+	if v, ok := envelope["event_version"].(float64); ok && v > 0 {
+		version = int(v)
+	}
+
+	upcaster, ok := c[version]
+	if !ok {
+		return raw, nil
+	}
+
+	data, _ := envelope["data"].(map[string]any)
+	if data == nil {
+		data = map[string]any{}
+	}
+
+	for ok {
+		if err := upcaster(data); err != nil {
+			return nil, fmt.Errorf("failed to upcast from version %d: %w", version, err)
+		}
+		version++
+		upcaster, ok = c[version]
+	}
+
+	envelope["data"] = data
+	envelope["event_version"] = version
+
+	return json.Marshal(envelope)
+}
@@ -0,0 +1,52 @@
+package events_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpcasterChain_AppliesEveryStep(t *testing.T) {
+	chain := events.UpcasterChain{
+		1: func(data map[string]any) error { data["v2"] = true; return nil },
+		2: func(data map[string]any) error { data["v3"] = true; return nil },
+	}
+
+	upcasted, err := chain.Upcast([]byte(`{"event_type":"thing","data":{"id":"1"}}`))
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"event_type":"thing","event_version":3,"data":{"id":"1","v2":true,"v3":true}}`, string(upcasted))
+}
+
+func TestUpcasterChain_StartsAtEnvelopeVersion(t *testing.T) {
+	chain := events.UpcasterChain{
+		1: func(data map[string]any) error { return errors.New("must not run") },
+		2: func(data map[string]any) error { data["v3"] = true; return nil },
+	}
+
+	upcasted, err := chain.Upcast([]byte(`{"event_version":2,"data":{}}`))
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"event_version":3,"data":{"v3":true}}`, string(upcasted))
+}
+
+func TestUpcasterChain_CurrentVersionIsUntouched(t *testing.T) {
+	chain := events.UpcasterChain{1: func(data map[string]any) error { return nil }}
+	raw := []byte(`{"event_version":2,"data":{}}`)
+
+	upcasted, err := chain.Upcast(raw)
+
+	require.NoError(t, err)
+	assert.Equal(t, raw, upcasted)
+}
+
+func TestUpcasterChain_PropagatesErrors(t *testing.T) {
+	chain := events.UpcasterChain{1: func(data map[string]any) error { return errors.New("boom") }}
+
+	_, err := chain.Upcast([]byte(`{"data":{}}`))
+
+	assert.ErrorContains(t, err, "boom")
+}
@@ -66,6 +66,14 @@ func NewValidationError(message, detail string) *ApiError {
 	}
 }
 
+func NewTooManyRequestsError(message, detail string) *ApiError {
+	return &ApiError{
+		Code:    429,
+		Message: message,
+		Detail:  detail,
+	}
+}
+
 func NewServiceUnavailableError(message, detail string) *ApiError {
 	return &ApiError{
 		Code:    503,
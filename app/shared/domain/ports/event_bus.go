@@ -2,9 +2,37 @@ package ports
 
 import (
 	"context"
+	"fmt"
 )
 
 type EventBus interface {
 	Publish(ctx context.Context, event DomainEvent, correlationID string) error
 	Close()
 }
+
+// PublishFailure clasifica por qué el broker no aceptó un evento.
+type PublishFailure string
+
+const (
+	PublishUnavailable PublishFailure = "unavailable" // sin conexión con el broker
+	PublishNacked      PublishFailure = "nacked"      // el broker rechazó el mensaje
+	PublishUnconfirmed PublishFailure = "unconfirmed" // no llegó la confirmación a tiempo
+	PublishUnroutable  PublishFailure = "unroutable"  // ninguna cola enlazada al routing key
+)
+
+// PublishError lo devuelve EventBus.Publish cuando no puede garantizar que el
+// broker persistió el evento. Se inspecciona con errors.As.
+type PublishError struct {
+	EventID   string
+	EventType string
+	Failure   PublishFailure
+	Err       error
+}
+
+func (e *PublishError) Error() string {
+	return fmt.Sprintf("publish %s (%s) %s: %v", e.EventType, e.EventID, e.Failure, e.Err)
+}
+
+func (e *PublishError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,15 @@
+package ports
+
+import "context"
+
+type eventIDKey struct{}
+
+// WithEventID asocia al contexto el ID del evento que se está procesando.
+func WithEventID(ctx context.Context, eventID string) context.Context {
+	return context.WithValue(ctx, eventIDKey{}, eventID)
+}
+
+func EventIDFromContext(ctx context.Context) (string, bool) {
+	eventID, ok := ctx.Value(eventIDKey{}).(string)
+	return eventID, ok && eventID != ""
+}
@@ -0,0 +1,11 @@
+package ports
+
+import "context"
+
+// EventRedactor borra datos personales de los eventos de un agregado que
+// siguen guardados después de publicarse, como los mensajes del outbox.
+type EventRedactor interface {
+	// Redact sustituye en el data de cada evento de aggregateID los campos de
+	// fields que el evento lleve; un valor nil elimina el campo.
+	Redact(ctx context.Context, aggregateID string, fields map[string]any) error
+}
@@ -0,0 +1,42 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// StreamEvent es un evento persistido en el stream de un agregado. Version es
+// la posición del evento en el stream, empezando en 1.
+type StreamEvent struct {
+	AggregateID string
+	TenantID    string
+	Version     int
+	Type        string
+	Payload     []byte
+	OccurredAt  time.Time
+}
+
+// Snapshot guarda el estado de un agregado tras aplicar el evento Version.
+type Snapshot struct {
+	AggregateID string
+	TenantID    string
+	Version     int
+	State       []byte
+}
+
+// EventStore es un almacén append-only de streams por agregado con control de
+// concurrencia optimista. La única excepción es Redact, para borrar datos
+// personales.
+type EventStore interface {
+	// Append añade eventos al stream si su versión actual es expectedVersion;
+	// si otro escritor se adelantó devuelve un error de conflicto (409).
+	Append(ctx context.Context, aggregateType string, expectedVersion int, events []StreamEvent) error
+	// Load devuelve los eventos del stream posteriores a afterVersion, en orden.
+	Load(ctx context.Context, aggregateType, aggregateID string, afterVersion int) ([]StreamEvent, error)
+	SaveSnapshot(ctx context.Context, aggregateType string, snapshot Snapshot) error
+	// LoadSnapshot devuelve nil si el agregado no tiene snapshot.
+	LoadSnapshot(ctx context.Context, aggregateType, aggregateID string) (*Snapshot, error)
+	// Redact sustituye el payload de los eventos del stream indicados por
+	// versión y borra el snapshot del agregado.
+	Redact(ctx context.Context, aggregateType, aggregateID string, payloads map[int][]byte) error
+}
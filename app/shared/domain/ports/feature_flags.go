@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// FeatureFlags evalúa si una funcionalidad está activa para un tenant. Un flag
+// que no existe se considera desactivado.
+type FeatureFlags interface {
+	IsEnabled(ctx context.Context, flag, tenantID string) (bool, error)
+}
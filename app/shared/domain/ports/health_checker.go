@@ -0,0 +1,6 @@
+package ports
+
+// HealthChecker lo implementan los adaptadores cuyo estado se expone en /ready.
+type HealthChecker interface {
+	Healthy() error
+}
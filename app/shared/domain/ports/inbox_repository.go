@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// InboxRepository registra qué eventos ya procesó cada consumidor.
+type InboxRepository interface {
+	// Record marca el evento como procesado por el consumidor. Devuelve false
+	// si ya lo estaba.
+	Record(ctx context.Context, consumer, eventID string) (bool, error)
+}
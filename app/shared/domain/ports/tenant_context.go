@@ -0,0 +1,16 @@
+package ports
+
+import "context"
+
+type tenantIDKey struct{}
+
+// WithTenantID asocia al contexto el tenant de la petición. La persistencia
+// lo usa para acotar las transacciones con row-level security.
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+func TenantIDFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantIDKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
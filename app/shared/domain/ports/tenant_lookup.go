@@ -0,0 +1,18 @@
+package ports
+
+import "context"
+
+// TenantAccess indica si un tenant puede operar.
+type TenantAccess int
+
+const (
+	TenantUnknown TenantAccess = iota
+	TenantActive
+	TenantSuspended
+)
+
+// TenantLookup consulta el estado de un tenant. TenantMiddleware lo llama en
+// cada petición, así que las implementaciones deben cachear.
+type TenantLookup interface {
+	Access(ctx context.Context, tenantID string) (TenantAccess, error)
+}
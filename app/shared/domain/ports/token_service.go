@@ -0,0 +1,19 @@
+package ports
+
+import "time"
+
+// TokenClaims son los datos que viajan en un access token.
+type TokenClaims struct {
+	Subject   string
+	TenantID  string
+	Role      string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+type TokenService interface {
+	// Issue firma un access token; IssuedAt y ExpiresAt los asigna el servicio.
+	Issue(claims TokenClaims) (string, error)
+	Parse(token string) (*TokenClaims, error)
+	AccessTokenTTL() time.Duration
+}
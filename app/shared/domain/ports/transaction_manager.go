@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type TransactionManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
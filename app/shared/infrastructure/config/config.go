@@ -1,21 +1,32 @@
 package config
 
 import (
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	API      APIConfig
-	DB       DBConfig
-	RabbitMQ RabbitMQConfig
-	App      AppConfig
+	API          APIConfig
+	DB           DBConfig
+	RabbitMQ     RabbitMQConfig
+	Outbox       OutboxConfig
+	Events       EventStoreConfig
+	Tenancy      TenancyConfig
+	FeatureFlags FeatureFlagsConfig
+	RateLimit    RateLimitConfig
+	Auth         AuthConfig
+	App          AppConfig
 }
 
 type APIConfig struct {
 	Port string
 	Host string
+
+	// Proxies (IP o CIDR) de los que se acepta X-Real-IP como IP del cliente.
+	// Vacío: la IP es la del extremo de la conexión
+	TrustedProxies []string
 }
 
 type DBConfig struct {
@@ -26,10 +37,21 @@ type DBConfig struct {
 	Name     string
 	SSLMode  string
 
+	// Rol de los procesos de sistema (migraciones, rebuild-projection): dueño
+	// de las tablas y con BYPASSRLS. User debe ser un rol sin esos atributos
+	// para que apliquen las políticas de row-level security.
+	SystemUser     string
+	SystemPassword string
+
 	MaxIdleConns    int
 	MaxOpenConns    int
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
+
+	// Pool aparte para las lecturas de control (tenants, feature flags, rate
+	// limiting) que se hacen mientras la petición retiene una conexión del
+	// pool principal
+	ControlPlaneMaxOpenConns int
 }
 
 type RabbitMQConfig struct {
@@ -38,6 +60,97 @@ type RabbitMQConfig struct {
 	User     string
 	Password string
 	VHost    string
+
+	// Reintentos del consumidor: el intento n espera RetryBaseDelay * 2^(n-1)
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+
+	// Tiempo máximo de espera del ack del broker por publicación
+	PublishConfirmTimeout time.Duration
+
+	// Mensajes sin confirmar que el broker entrega a la vez y workers que los
+	// procesan. Los eventos de un mismo agregado siempre van al mismo worker.
+	PrefetchCount   int
+	ConsumerWorkers int
+
+	// Formato en el que se publican los eventos: native, cloudevents-binary o
+	// cloudevents-structured. El consumidor acepta los tres.
+	EventFormat       string
+	CloudEventsSource string
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MetricsPort  string
+
+	// Un mensaje que no se publica espera RetryBaseDelay * 2^(n-1) antes del
+	// intento n+1; tras MaxAttempts queda como fallido
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+}
+
+// EventStoreConfig elige cómo se persisten los usuarios: state (tabla users)
+// o event_sourced (stream de eventos con un snapshot cada SnapshotEvery
+// eventos). Cambiar de modo no migra los datos existentes.
+type EventStoreConfig struct {
+	UserPersistence string
+	SnapshotEvery   int
+}
+
+const (
+	UserPersistenceState        = "state"
+	UserPersistenceEventSourced = "event_sourced"
+)
+
+// TenancyConfig elige el aislamiento físico entre tenants: shared (tablas
+// compartidas discriminadas por tenant_id), schema (un esquema por tenant en
+// la base de datos de DBConfig) o database (una base de datos por tenant con
+// su DSN en Databases). En schema y database cada tenant tiene su propio pool
+// de hasta MaxOpenConns conexiones. CacheTTL es cuánto tarda en notarse una
+// suspensión o un alta en TenantMiddleware.
+type TenancyConfig struct {
+	Mode         string
+	Tenants      []string
+	Databases    map[string]string
+	MaxOpenConns int
+	CacheTTL     time.Duration
+}
+
+const (
+	TenancyShared   = "shared"
+	TenancySchema   = "schema"
+	TenancyDatabase = "database"
+)
+
+// FeatureFlagsConfig: CacheTTL es cuánto tarda en verse un cambio de flag en
+// cada réplica.
+type FeatureFlagsConfig struct {
+	CacheTTL time.Duration
+}
+
+// RateLimitConfig elige dónde se guardan los cupos: memory (cada réplica
+// limita por su cuenta) o postgres (compartidos entre réplicas). Cada ruta
+// permite N peticiones por Window y tenant.
+type RateLimitConfig struct {
+	Store  string
+	Window time.Duration
+}
+
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+// AuthConfig configura la emisión de tokens. JWTAlgorithm admite HS256 (usa
+// JWTSecret) o RS256 (usa la clave privada PEM en JWTPrivateKeyPath).
+type AuthConfig struct {
+	JWTAlgorithm      string
+	JWTSecret         string
+	JWTPrivateKeyPath string
+	JWTIssuer         string
+	AccessTokenTTL    time.Duration
+	RefreshTokenTTL   time.Duration
 }
 
 type AppConfig struct {
@@ -55,6 +168,8 @@ func LoadConfig() (*Config, error) {
 		API: APIConfig{
 			Port: getEnvOrDefault("API_PORT", "8080"),
 			Host: getEnvOrDefault("API_HOST", "0.0.0.0"),
+
+			TrustedProxies: getListOrDefault("API_TRUSTED_PROXIES", nil),
 		},
 		DB: DBConfig{
 			Host:            getEnvOrDefault("DB_HOST", "localhost"),
@@ -63,10 +178,14 @@ func LoadConfig() (*Config, error) {
 			Password:        getEnvOrDefault("DB_PASSWORD", "postgres"),
 			Name:            getEnvOrDefault("DB_NAME", "backend_hex_cqrs"),
 			SSLMode:         getEnvOrDefault("DB_SSL_MODE", "disable"),
+			SystemUser:      getEnvOrDefault("DB_SYSTEM_USER", getEnvOrDefault("DB_USER", "postgres")),
+			SystemPassword:  getEnvOrDefault("DB_SYSTEM_PASSWORD", getEnvOrDefault("DB_PASSWORD", "postgres")),
 			MaxIdleConns:    10,
 			MaxOpenConns:    100,
 			ConnMaxLifetime: time.Hour,
 			ConnMaxIdleTime: 10 * time.Minute,
+
+			ControlPlaneMaxOpenConns: getIntOrDefault("DB_CONTROL_PLANE_MAX_OPEN_CONNS", 10),
 		},
 		RabbitMQ: RabbitMQConfig{
 			Host:     getEnvOrDefault("RABBITMQ_HOST", "localhost"),
@@ -74,6 +193,51 @@ func LoadConfig() (*Config, error) {
 			User:     getEnvOrDefault("RABBITMQ_USER", "guest"),
 			Password: getEnvOrDefault("RABBITMQ_PASSWORD", "guest"),
 			VHost:    getEnvOrDefault("RABBITMQ_VHOST", "/"),
+
+			MaxRetries:     getIntOrDefault("RABBITMQ_MAX_RETRIES", 5),
+			RetryBaseDelay: getDurationOrDefault("RABBITMQ_RETRY_BASE_DELAY", time.Second),
+
+			PublishConfirmTimeout: getDurationOrDefault("RABBITMQ_PUBLISH_CONFIRM_TIMEOUT", 5*time.Second),
+
+			PrefetchCount:   getIntOrDefault("RABBITMQ_PREFETCH_COUNT", 20),
+			ConsumerWorkers: getIntOrDefault("RABBITMQ_CONSUMER_WORKERS", 4),
+
+			EventFormat:       getEnvOrDefault("RABBITMQ_EVENT_FORMAT", "native"),
+			CloudEventsSource: getEnvOrDefault("RABBITMQ_CLOUDEVENTS_SOURCE", "/go-hexagonal"),
+		},
+		Outbox: OutboxConfig{
+			PollInterval: getDurationOrDefault("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    getIntOrDefault("OUTBOX_BATCH_SIZE", 100),
+			MetricsPort:  getEnvOrDefault("OUTBOX_METRICS_PORT", "9090"),
+
+			MaxAttempts:    getIntOrDefault("OUTBOX_MAX_ATTEMPTS", 10),
+			RetryBaseDelay: getDurationOrDefault("OUTBOX_RETRY_BASE_DELAY", time.Second),
+		},
+		Events: EventStoreConfig{
+			UserPersistence: getEnvOrDefault("USER_PERSISTENCE", UserPersistenceState),
+			SnapshotEvery:   getIntOrDefault("EVENT_STORE_SNAPSHOT_EVERY", 20),
+		},
+		Tenancy: TenancyConfig{
+			Mode:         getEnvOrDefault("TENANCY_MODE", TenancyShared),
+			Tenants:      getListOrDefault("TENANCY_TENANTS", nil),
+			Databases:    getMapOrDefault("TENANCY_DATABASES"),
+			MaxOpenConns: getIntOrDefault("TENANCY_MAX_OPEN_CONNS", 10),
+			CacheTTL:     getDurationOrDefault("TENANCY_CACHE_TTL", 30*time.Second),
+		},
+		FeatureFlags: FeatureFlagsConfig{
+			CacheTTL: getDurationOrDefault("FEATURE_FLAGS_CACHE_TTL", 30*time.Second),
+		},
+		RateLimit: RateLimitConfig{
+			Store:  getEnvOrDefault("RATE_LIMIT_STORE", RateLimitStoreMemory),
+			Window: getDurationOrDefault("RATE_LIMIT_WINDOW", time.Minute),
+		},
+		Auth: AuthConfig{
+			JWTAlgorithm:      getEnvOrDefault("JWT_ALGORITHM", "HS256"),
+			JWTSecret:         getEnvOrDefault("JWT_SECRET", ""),
+			JWTPrivateKeyPath: getEnvOrDefault("JWT_PRIVATE_KEY_PATH", ""),
+			JWTIssuer:         getEnvOrDefault("JWT_ISSUER", "go-hexagonal"),
+			AccessTokenTTL:    getDurationOrDefault("JWT_ACCESS_TOKEN_TTL", 15*time.Minute),
+			RefreshTokenTTL:   getDurationOrDefault("JWT_REFRESH_TOKEN_TTL", 30*24*time.Hour),
 		},
 		App: AppConfig{
 			LogLevel:    getEnvOrDefault("LOG_LEVEL", "info"),
@@ -82,7 +246,56 @@ func LoadConfig() (*Config, error) {
 	}, nil
 }
 
+// AsSystem devuelve una copia de la configuración que conecta con el rol de
+// sistema.
+func (c *Config) AsSystem() *Config {
+	system := *c
+	system.DB.User = c.DB.SystemUser
+	system.DB.Password = c.DB.SystemPassword
+	return &system
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	viper.SetDefault(key, defaultValue)
 	return viper.GetString(key)
 }
+
+func getIntOrDefault(key string, defaultValue int) int {
+	viper.SetDefault(key, defaultValue)
+	return viper.GetInt(key)
+}
+
+// getListOrDefault lee una lista separada por comas: "acme,globex".
+func getListOrDefault(key string, defaultValue []string) []string {
+	raw := getEnvOrDefault(key, "")
+	if raw == "" {
+		return defaultValue
+	}
+
+	var values []string
+	for _, value := range strings.Split(raw, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getMapOrDefault lee pares clave=valor separados por punto y coma. Solo el
+// primer = separa la clave, así que el valor puede ser un DSN:
+// "acme=host=db-acme dbname=acme;globex=postgres://globex@db/globex".
+func getMapOrDefault(key string) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(getEnvOrDefault(key, ""), ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok && strings.TrimSpace(name) != "" {
+			values[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
+
+func getDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	viper.SetDefault(key, defaultValue)
+	return viper.GetDuration(key)
+}
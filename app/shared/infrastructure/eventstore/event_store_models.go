@@ -0,0 +1,35 @@
+package eventstore
+
+import "time"
+
+// StoredEventModel es una fila del event store. El índice único sobre
+// (aggregate_type, aggregate_id, version) garantiza que dos escritores
+// concurrentes no puedan añadir la misma versión.
+type StoredEventModel struct {
+	ID            uint64    `gorm:"primaryKey;autoIncrement"`
+	AggregateType string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_event_store_stream,priority:1"`
+	AggregateID   string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_event_store_stream,priority:2"`
+	Version       int       `gorm:"not null;uniqueIndex:idx_event_store_stream,priority:3"`
+	TenantID      string    `gorm:"type:varchar(100);not null;index:idx_event_store_tenant"`
+	EventType     string    `gorm:"type:varchar(100);not null"`
+	Payload       string    `gorm:"type:jsonb;not null"`
+	OccurredAt    time.Time `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (StoredEventModel) TableName() string {
+	return "event_store"
+}
+
+type SnapshotModel struct {
+	AggregateType string    `gorm:"type:varchar(100);primaryKey"`
+	AggregateID   string    `gorm:"type:varchar(100);primaryKey"`
+	TenantID      string    `gorm:"type:varchar(100);not null"`
+	Version       int       `gorm:"not null"`
+	State         string    `gorm:"type:jsonb;not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (SnapshotModel) TableName() string {
+	return "event_store_snapshots"
+}
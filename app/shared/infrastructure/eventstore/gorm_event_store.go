@@ -0,0 +1,156 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrConcurrencyConflict = exceptions.NewConflictError("concurrent modification", "the aggregate was modified by another request, retry the operation")
+
+type GormEventStore struct {
+	db *gorm.DB
+}
+
+func NewGormEventStore(db *gorm.DB) *GormEventStore {
+	return &GormEventStore{db: db}
+}
+
+func (s *GormEventStore) Append(ctx context.Context, aggregateType string, expectedVersion int, events []ports.StreamEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	db := transaction.DB(ctx, s.db)
+
+	var current int
+	if err := db.Model(&StoredEventModel{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("aggregate_type = ? AND aggregate_id = ?", aggregateType, events[0].AggregateID).
+		Scan(&current).Error; err != nil {
+		return exceptions.NewInternalServerError("failed to read stream version", err.Error())
+	}
+	if current != expectedVersion {
+		return ErrConcurrencyConflict
+	}
+
+	models := make([]StoredEventModel, len(events))
+	for i, event := range events {
+		models[i] = StoredEventModel{
+			AggregateType: aggregateType,
+			AggregateID:   event.AggregateID,
+			Version:       expectedVersion + i + 1,
+			TenantID:      event.TenantID,
+			EventType:     event.Type,
+			Payload:       string(event.Payload),
+			OccurredAt:    event.OccurredAt,
+		}
+	}
+
+	// Si otro escritor insertó la misma versión entre la lectura y el insert,
+	// el índice único lo detecta
+	if err := db.Create(&models).Error; err != nil {
+		if isUniqueViolation(err) {
+			return ErrConcurrencyConflict
+		}
+		return exceptions.NewInternalServerError("failed to append events", err.Error())
+	}
+
+	return nil
+}
+
+func (s *GormEventStore) Load(ctx context.Context, aggregateType, aggregateID string, afterVersion int) ([]ports.StreamEvent, error) {
+	var models []StoredEventModel
+
+	if err := transaction.DB(ctx, s.db).
+		Where("aggregate_type = ? AND aggregate_id = ? AND version > ?", aggregateType, aggregateID, afterVersion).
+		Order("version").
+		Find(&models).Error; err != nil {
+		return nil, exceptions.NewInternalServerError("failed to load stream", err.Error())
+	}
+
+	events := make([]ports.StreamEvent, len(models))
+	for i, model := range models {
+		events[i] = ports.StreamEvent{
+			AggregateID: model.AggregateID,
+			TenantID:    model.TenantID,
+			Version:     model.Version,
+			Type:        model.EventType,
+			Payload:     []byte(model.Payload),
+			OccurredAt:  model.OccurredAt,
+		}
+	}
+
+	return events, nil
+}
+
+func (s *GormEventStore) SaveSnapshot(ctx context.Context, aggregateType string, snapshot ports.Snapshot) error {
+	model := &SnapshotModel{
+		AggregateType: aggregateType,
+		AggregateID:   snapshot.AggregateID,
+		TenantID:      snapshot.TenantID,
+		Version:       snapshot.Version,
+		State:         string(snapshot.State),
+	}
+
+	if err := transaction.DB(ctx, s.db).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(model).Error; err != nil {
+		return exceptions.NewInternalServerError("failed to save snapshot", err.Error())
+	}
+
+	return nil
+}
+
+func (s *GormEventStore) LoadSnapshot(ctx context.Context, aggregateType, aggregateID string) (*ports.Snapshot, error) {
+	var model SnapshotModel
+
+	err := transaction.DB(ctx, s.db).
+		Where("aggregate_type = ? AND aggregate_id = ?", aggregateType, aggregateID).
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, exceptions.NewInternalServerError("failed to load snapshot", err.Error())
+	}
+
+	return &ports.Snapshot{
+		AggregateID: model.AggregateID,
+		TenantID:    model.TenantID,
+		Version:     model.Version,
+		State:       []byte(model.State),
+	}, nil
+}
+
+func (s *GormEventStore) Redact(ctx context.Context, aggregateType, aggregateID string, payloads map[int][]byte) error {
+	db := transaction.DB(ctx, s.db)
+
+	for version, payload := range payloads {
+		if err := db.Model(&StoredEventModel{}).
+			Where("aggregate_type = ? AND aggregate_id = ? AND version = ?", aggregateType, aggregateID, version).
+			Update("payload", string(payload)).Error; err != nil {
+			return exceptions.NewInternalServerError("failed to redact stream", err.Error())
+		}
+	}
+
+	if err := db.
+		Where("aggregate_type = ? AND aggregate_id = ?", aggregateType, aggregateID).
+		Delete(&SnapshotModel{}).Error; err != nil {
+		return exceptions.NewInternalServerError("failed to delete snapshot", err.Error())
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	return errors.Is(err, gorm.ErrDuplicatedKey) ||
+		strings.Contains(err.Error(), "duplicate key") ||
+		strings.Contains(err.Error(), "unique constraint")
+}
@@ -0,0 +1,95 @@
+package eventstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// InMemoryEventStore implementa ports.EventStore sin base de datos. Está
+// pensado para tests y no participa en transacciones.
+type InMemoryEventStore struct {
+	mu        sync.Mutex
+	streams   map[string][]ports.StreamEvent
+	snapshots map[string]ports.Snapshot
+}
+
+func NewInMemoryEventStore() *InMemoryEventStore {
+	return &InMemoryEventStore{
+		streams:   make(map[string][]ports.StreamEvent),
+		snapshots: make(map[string]ports.Snapshot),
+	}
+}
+
+func streamKey(aggregateType, aggregateID string) string {
+	return aggregateType + "/" + aggregateID
+}
+
+func (s *InMemoryEventStore) Append(ctx context.Context, aggregateType string, expectedVersion int, events []ports.StreamEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := streamKey(aggregateType, events[0].AggregateID)
+	if len(s.streams[key]) != expectedVersion {
+		return ErrConcurrencyConflict
+	}
+
+	for i, event := range events {
+		event.Version = expectedVersion + i + 1
+		s.streams[key] = append(s.streams[key], event)
+	}
+
+	return nil
+}
+
+func (s *InMemoryEventStore) Load(ctx context.Context, aggregateType, aggregateID string, afterVersion int) ([]ports.StreamEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []ports.StreamEvent
+	for _, event := range s.streams[streamKey(aggregateType, aggregateID)] {
+		if event.Version > afterVersion {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *InMemoryEventStore) SaveSnapshot(ctx context.Context, aggregateType string, snapshot ports.Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.snapshots[streamKey(aggregateType, snapshot.AggregateID)] = snapshot
+	return nil
+}
+
+func (s *InMemoryEventStore) LoadSnapshot(ctx context.Context, aggregateType, aggregateID string) (*ports.Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot, ok := s.snapshots[streamKey(aggregateType, aggregateID)]
+	if !ok {
+		return nil, nil
+	}
+	return &snapshot, nil
+}
+
+func (s *InMemoryEventStore) Redact(ctx context.Context, aggregateType, aggregateID string, payloads map[int][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := streamKey(aggregateType, aggregateID)
+	for i, event := range s.streams[key] {
+		if payload, ok := payloads[event.Version]; ok {
+			s.streams[key][i].Payload = payload
+		}
+	}
+	delete(s.snapshots, key)
+	return nil
+}
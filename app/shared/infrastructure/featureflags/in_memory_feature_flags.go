@@ -0,0 +1,39 @@
+package featureflags
+
+import (
+	"context"
+	"sync"
+)
+
+// InMemoryFeatureFlags implementa ports.FeatureFlags sin base de datos. Está
+// pensado para tests: los flags están desactivados salvo que se fijen con Set.
+type InMemoryFeatureFlags struct {
+	mu    sync.Mutex
+	flags map[string]map[string]bool
+}
+
+func NewInMemoryFeatureFlags() *InMemoryFeatureFlags {
+	return &InMemoryFeatureFlags{flags: make(map[string]map[string]bool)}
+}
+
+// Set fija el valor del flag para tenantID; con tenantID vacío, para todos los
+// tenants sin valor propio.
+func (f *InMemoryFeatureFlags) Set(flag, tenantID string, enabled bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.flags[flag] == nil {
+		f.flags[flag] = make(map[string]bool)
+	}
+	f.flags[flag][tenantID] = enabled
+}
+
+func (f *InMemoryFeatureFlags) IsEnabled(ctx context.Context, flag, tenantID string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if enabled, ok := f.flags[flag][tenantID]; ok {
+		return enabled, nil
+	}
+	return f.flags[flag][""], nil
+}
@@ -0,0 +1,38 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormInboxRepository struct {
+	db *gorm.DB
+}
+
+func NewGormInboxRepository(db *gorm.DB) *GormInboxRepository {
+	return &GormInboxRepository{db: db}
+}
+
+// Record inserta ignorando conflictos: si otra entrega del mismo evento está
+// en curso, la inserción espera a que su transacción termine.
+func (r *GormInboxRepository) Record(ctx context.Context, consumer, eventID string) (bool, error) {
+	model := &InboxMessageModel{
+		Consumer:    consumer,
+		EventID:     eventID,
+		ProcessedAt: time.Now(),
+	}
+
+	result := transaction.DB(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to record inbox message: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,50 @@
+package inbox
+
+import (
+	"context"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// InboxEventHandler decora un EventHandler para que cada evento se procese una
+// sola vez por consumidor. El registro en el inbox y los cambios del handler
+// se confirman en la misma transacción; un duplicado se descarta sin efectos.
+type InboxEventHandler struct {
+	consumer  string
+	inbox     ports.InboxRepository
+	txManager ports.TransactionManager
+	next      ports.EventHandler
+}
+
+func NewInboxEventHandler(
+	consumer string,
+	inbox ports.InboxRepository,
+	txManager ports.TransactionManager,
+	next ports.EventHandler,
+) *InboxEventHandler {
+	return &InboxEventHandler{
+		consumer:  consumer,
+		inbox:     inbox,
+		txManager: txManager,
+		next:      next,
+	}
+}
+
+func (h *InboxEventHandler) HandleEvent(ctx context.Context, eventType string, data []byte) error {
+	eventID, ok := ports.EventIDFromContext(ctx)
+
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Sin ID no hay forma de deduplicar: se procesa igualmente
+		if ok {
+			fresh, err := h.inbox.Record(ctx, h.consumer, eventID)
+			if err != nil {
+				return err
+			}
+			if !fresh {
+				return nil
+			}
+		}
+
+		return h.next.HandleEvent(ctx, eventType, data)
+	})
+}
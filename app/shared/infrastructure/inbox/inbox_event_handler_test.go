@@ -0,0 +1,109 @@
+package inbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/inbox"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockInboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockInboxRepository) Record(ctx context.Context, consumer, eventID string) (bool, error) {
+	args := m.Called(ctx, consumer, eventID)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockEventHandler struct {
+	mock.Mock
+}
+
+func (m *MockEventHandler) HandleEvent(ctx context.Context, eventType string, data []byte) error {
+	args := m.Called(ctx, eventType, data)
+	return args.Error(0)
+}
+
+type InboxEventHandlerSuite struct {
+	suite.Suite
+	repo      *MockInboxRepository
+	next      *MockEventHandler
+	txManager *transaction.InMemoryTransactionManager
+	handler   *inbox.InboxEventHandler
+	ctx       context.Context
+}
+
+func (s *InboxEventHandlerSuite) SetupTest() {
+	s.repo = new(MockInboxRepository)
+	s.next = new(MockEventHandler)
+	s.txManager = transaction.NewInMemoryTransactionManager()
+	s.handler = inbox.NewInboxEventHandler("user_projections", s.repo, s.txManager, s.next)
+	s.ctx = ports.WithEventID(context.Background(), "event-1")
+}
+
+func TestInboxEventHandlerSuite(t *testing.T) {
+	suite.Run(t, new(InboxEventHandlerSuite))
+}
+
+func (s *InboxEventHandlerSuite) TestHandleEvent_FirstDelivery() {
+	data := []byte(`{}`)
+	inTx := mock.MatchedBy(transaction.InTransaction)
+
+	s.repo.On("Record", inTx, "user_projections", "event-1").Return(true, nil).Once()
+	s.next.On("HandleEvent", inTx, "user.created", data).Return(nil).Once()
+
+	err := s.handler.HandleEvent(s.ctx, "user.created", data)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, s.txManager.Commits())
+	s.repo.AssertExpectations(s.T())
+	s.next.AssertExpectations(s.T())
+}
+
+func (s *InboxEventHandlerSuite) TestHandleEvent_DuplicateIsSkipped() {
+	s.repo.On("Record", mock.Anything, "user_projections", "event-1").Return(false, nil).Once()
+
+	err := s.handler.HandleEvent(s.ctx, "user.created", []byte(`{}`))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, s.txManager.Commits())
+	s.next.AssertNotCalled(s.T(), "HandleEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *InboxEventHandlerSuite) TestHandleEvent_HandlerFailureRollsBackInbox() {
+	s.repo.On("Record", mock.Anything, "user_projections", "event-1").Return(true, nil).Once()
+	s.next.On("HandleEvent", mock.Anything, "user.created", mock.Anything).Return(errors.New("db down")).Once()
+
+	err := s.handler.HandleEvent(s.ctx, "user.created", []byte(`{}`))
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), 0, s.txManager.Commits())
+	assert.Equal(s.T(), 1, s.txManager.Rollbacks())
+}
+
+func (s *InboxEventHandlerSuite) TestHandleEvent_InboxFailure() {
+	s.repo.On("Record", mock.Anything, "user_projections", "event-1").Return(false, errors.New("db down")).Once()
+
+	err := s.handler.HandleEvent(s.ctx, "user.created", []byte(`{}`))
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), 1, s.txManager.Rollbacks())
+	s.next.AssertNotCalled(s.T(), "HandleEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *InboxEventHandlerSuite) TestHandleEvent_WithoutEventID() {
+	s.next.On("HandleEvent", mock.Anything, "user.created", mock.Anything).Return(nil).Once()
+
+	err := s.handler.HandleEvent(context.Background(), "user.created", []byte(`{}`))
+
+	assert.NoError(s.T(), err)
+	s.repo.AssertNotCalled(s.T(), "Record", mock.Anything, mock.Anything, mock.Anything)
+	s.next.AssertExpectations(s.T())
+}
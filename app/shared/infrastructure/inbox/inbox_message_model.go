@@ -0,0 +1,13 @@
+package inbox
+
+import "time"
+
+type InboxMessageModel struct {
+	Consumer    string    `gorm:"type:varchar(100);primaryKey"`
+	EventID     string    `gorm:"type:varchar(100);primaryKey"`
+	ProcessedAt time.Time `gorm:"not null;index:idx_inbox_processed_at"`
+}
+
+func (InboxMessageModel) TableName() string {
+	return "inbox_messages"
+}
@@ -0,0 +1,90 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+)
+
+type handlerFunc func(ctx context.Context, data []byte) error
+
+// EventRegistry asocia cada tipo de evento con el tipo Go al que se
+// deserializa y con sus handlers. Implementa ports.EventHandler y sus tipos
+// registrados son los bindings de la cola del consumidor.
+type EventRegistry struct {
+	types      map[string]reflect.Type
+	handlers   map[string][]handlerFunc
+	upcasters  map[string]events.UpcasterChain
+	eventTypes []string
+}
+
+func NewEventRegistry() *EventRegistry {
+	return &EventRegistry{
+		types:     make(map[string]reflect.Type),
+		handlers:  make(map[string][]handlerFunc),
+		upcasters: make(map[string]events.UpcasterChain),
+	}
+}
+
+// Register añade un handler para eventType, que se deserializa en E. Un mismo
+// tipo de evento admite varios handlers, pero siempre con el mismo E.
+func Register[E any](r *EventRegistry, eventType string, handler func(ctx context.Context, event *E) error) {
+	goType := reflect.TypeFor[E]()
+
+	if registered, ok := r.types[eventType]; ok && registered != goType {
+		panic(fmt.Sprintf("event type %s already registered as %s, got %s", eventType, registered, goType))
+	}
+
+	if _, ok := r.types[eventType]; !ok {
+		r.types[eventType] = goType
+		r.eventTypes = append(r.eventTypes, eventType)
+	}
+
+	r.handlers[eventType] = append(r.handlers[eventType], func(ctx context.Context, data []byte) error {
+		event := new(E)
+		if err := json.Unmarshal(data, event); err != nil {
+			return exceptions.NewBadRequestError(fmt.Sprintf("failed to unmarshal %s event", eventType), err.Error())
+		}
+		return handler(ctx, event)
+	})
+}
+
+// Upcast registra los upcasters de eventType. Los payloads de versiones
+// anteriores se llevan a la actual antes de deserializarlos.
+func (r *EventRegistry) Upcast(eventType string, chain events.UpcasterChain) {
+	r.upcasters[eventType] = chain
+}
+
+// EventTypes devuelve los tipos registrados en orden de registro.
+func (r *EventRegistry) EventTypes() []string {
+	return append([]string(nil), r.eventTypes...)
+}
+
+// HandleEvent ejecuta en orden los handlers del tipo de evento y se detiene en
+// el primer error.
+func (r *EventRegistry) HandleEvent(ctx context.Context, eventType string, data []byte) error {
+	handlers, ok := r.handlers[eventType]
+	if !ok {
+		return exceptions.NewBadRequestError("unknown event type", fmt.Sprintf("event type %s is not recognized", eventType))
+	}
+
+	if chain, ok := r.upcasters[eventType]; ok {
+		upcasted, err := chain.Upcast(data)
+		if err != nil {
+			return exceptions.NewBadRequestError(fmt.Sprintf("failed to upcast %s event", eventType), err.Error())
+		}
+		data = upcasted
+	}
+
+	for _, handle := range handlers {
+		if err := handle(ctx, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/events"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+type thingCreated struct {
+	ID string `json:"id"`
+}
+
+type thingDeleted struct {
+	ID string `json:"id"`
+}
+
+func TestEventRegistry_DispatchesToRegisteredHandlers(t *testing.T) {
+	registry := messaging.NewEventRegistry()
+
+	var received []string
+	messaging.Register(registry, "thing.created", func(ctx context.Context, e *thingCreated) error {
+		received = append(received, "first:"+e.ID)
+		return nil
+	})
+	messaging.Register(registry, "thing.created", func(ctx context.Context, e *thingCreated) error {
+		received = append(received, "second:"+e.ID)
+		return nil
+	})
+
+	err := registry.HandleEvent(context.Background(), "thing.created", []byte(`{"id":"1"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first:1", "second:1"}, received)
+}
+
+func TestEventRegistry_EventTypesFollowRegistrationOrder(t *testing.T) {
+	registry := messaging.NewEventRegistry()
+	noop := func(ctx context.Context, e *thingCreated) error { return nil }
+
+	messaging.Register(registry, "thing.created", noop)
+	messaging.Register(registry, "thing.updated", noop)
+	messaging.Register(registry, "thing.created", noop)
+
+	assert.Equal(t, []string{"thing.created", "thing.updated"}, registry.EventTypes())
+}
+
+func TestEventRegistry_UnknownEventType(t *testing.T) {
+	registry := messaging.NewEventRegistry()
+
+	err := registry.HandleEvent(context.Background(), "thing.created", []byte(`{}`))
+
+	var apiErr *exceptions.ApiError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 400, apiErr.Code)
+}
+
+func TestEventRegistry_InvalidPayload(t *testing.T) {
+	registry := messaging.NewEventRegistry()
+	messaging.Register(registry, "thing.created", func(ctx context.Context, e *thingCreated) error {
+		t.Fatal("handler must not run")
+		return nil
+	})
+
+	err := registry.HandleEvent(context.Background(), "thing.created", []byte(`not json`))
+
+	var apiErr *exceptions.ApiError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 400, apiErr.Code)
+}
+
+func TestEventRegistry_StopsOnFirstError(t *testing.T) {
+	registry := messaging.NewEventRegistry()
+	calls := 0
+	messaging.Register(registry, "thing.created", func(ctx context.Context, e *thingCreated) error {
+		calls++
+		return errors.New("boom")
+	})
+	messaging.Register(registry, "thing.created", func(ctx context.Context, e *thingCreated) error {
+		calls++
+		return nil
+	})
+
+	err := registry.HandleEvent(context.Background(), "thing.created", []byte(`{}`))
+
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, 1, calls)
+}
+
+func TestEventRegistry_ConflictingGoTypePanics(t *testing.T) {
+	registry := messaging.NewEventRegistry()
+	messaging.Register(registry, "thing.created", func(ctx context.Context, e *thingCreated) error { return nil })
+
+	assert.Panics(t, func() {
+		messaging.Register(registry, "thing.created", func(ctx context.Context, e *thingDeleted) error { return nil })
+	})
+}
+
+type thingRenamed struct {
+	EventVersion int `json:"event_version"`
+	Data         struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+func TestEventRegistry_UpcastsBeforeDecoding(t *testing.T) {
+	registry := messaging.NewEventRegistry()
+
+	var received *thingRenamed
+	messaging.Register(registry, "thing.renamed", func(ctx context.Context, e *thingRenamed) error {
+		received = e
+		return nil
+	})
+	registry.Upcast("thing.renamed", events.UpcasterChain{
+		1: func(data map[string]any) error {
+			data["id"] = data["legacy_id"]
+			return nil
+		},
+	})
+
+	err := registry.HandleEvent(context.Background(), "thing.renamed", []byte(`{"data":{"legacy_id":"1"}}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, received.EventVersion)
+	assert.Equal(t, "1", received.Data.ID)
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrMissingBearerToken = exceptions.NewUnauthorizedError("missing bearer token", "Authorization: Bearer <token> header is required")
+	ErrTenantMismatch     = exceptions.NewForbiddenError("tenant mismatch", "X-Tenant-Id does not match the authenticated tenant")
+)
+
+// PublicRoute es una ruta que admite peticiones anónimas.
+type PublicRoute struct {
+	Method string
+	Path   string
+}
+
+// AuthMiddleware valida el bearer token y guarda "user_id", "tenant_id" y
+// "role" en c.Locals a partir de sus claims. Sin token solo se aceptan las rutas
+// públicas; en ellas el tenant lo resuelve después TenantMiddleware.
+func AuthMiddleware(tokenService ports.TokenService, publicRoutes ...PublicRoute) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		header := c.Get(fiber.HeaderAuthorization)
+
+		if header == "" {
+			if isPublicRoute(c, publicRoutes) {
+				return c.Next()
+			}
+			return ErrMissingBearerToken
+		}
+
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || token == "" {
+			return ErrMissingBearerToken
+		}
+
+		claims, err := tokenService.Parse(token)
+		if err != nil {
+			return err
+		}
+
+		if tenantID := c.Get("X-Tenant-Id"); tenantID != "" && tenantID != claims.TenantID {
+			return ErrTenantMismatch
+		}
+
+		c.Locals("user_id", claims.Subject)
+		c.Locals("tenant_id", claims.TenantID)
+		c.Locals("role", claims.Role)
+		return c.Next()
+	}
+}
+
+func isPublicRoute(c *fiber.Ctx, publicRoutes []PublicRoute) bool {
+	for _, route := range publicRoutes {
+		if route.Method == c.Method() && route.Path == c.Path() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/security"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/tenancy"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupAuthApp(t *testing.T) (*fiber.App, *security.JWTTokenService) {
+	tokenService, err := security.NewJWTTokenService(&config.AuthConfig{
+		JWTAlgorithm:   "HS256",
+		JWTSecret:      "test-secret-with-at-least-32-bytes!",
+		JWTIssuer:      "go-hexagonal",
+		AccessTokenTTL: time.Minute,
+	})
+	require.NoError(t, err)
+
+	app := fiber.New(fiber.Config{ErrorHandler: middleware.ErrorHandler(zap.NewNop())})
+	app.Use(middleware.AuthMiddleware(tokenService,
+		middleware.PublicRoute{Method: fiber.MethodGet, Path: "/health"},
+		middleware.PublicRoute{Method: fiber.MethodPost, Path: "/users"},
+	))
+	app.Get("/health", func(c *fiber.Ctx) error { return c.SendString("ok") })
+	app.Use(middleware.TenantMiddleware(tenancy.NewInMemoryTenantLookup(ports.TenantActive)))
+
+	whoami := func(c *fiber.Ctx) error {
+		userID, _ := c.Locals("user_id").(string)
+		return c.SendString(c.Locals("tenant_id").(string) + "|" + userID)
+	}
+	app.Post("/users", whoami)
+	app.Get("/users/me", whoami)
+
+	return app, tokenService
+}
+
+func doRequest(t *testing.T, app *fiber.App, method, path string, headers map[string]string) (int, string) {
+	req := httptest.NewRequest(method, path, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestAuthMiddleware_TenantFromToken(t *testing.T) {
+	app, tokenService := setupAuthApp(t)
+	token, _ := tokenService.Issue(ports.TokenClaims{Subject: "user-1", TenantID: "tenant-123"})
+
+	status, body := doRequest(t, app, http.MethodGet, "/users/me", map[string]string{"Authorization": "Bearer " + token})
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "tenant-123|user-1", body)
+}
+
+func TestAuthMiddleware_MatchingTenantHeader(t *testing.T) {
+	app, tokenService := setupAuthApp(t)
+	token, _ := tokenService.Issue(ports.TokenClaims{Subject: "user-1", TenantID: "tenant-123"})
+
+	status, _ := doRequest(t, app, http.MethodGet, "/users/me", map[string]string{
+		"Authorization": "Bearer " + token,
+		"X-Tenant-Id":   "tenant-123",
+	})
+
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestAuthMiddleware_ConflictingTenantHeader(t *testing.T) {
+	app, tokenService := setupAuthApp(t)
+	token, _ := tokenService.Issue(ports.TokenClaims{Subject: "user-1", TenantID: "tenant-123"})
+
+	status, _ := doRequest(t, app, http.MethodGet, "/users/me", map[string]string{
+		"Authorization": "Bearer " + token,
+		"X-Tenant-Id":   "tenant-999",
+	})
+
+	assert.Equal(t, http.StatusForbidden, status)
+}
+
+func TestAuthMiddleware_MissingTokenOnProtectedRoute(t *testing.T) {
+	app, _ := setupAuthApp(t)
+
+	status, _ := doRequest(t, app, http.MethodGet, "/users/me", map[string]string{"X-Tenant-Id": "tenant-123"})
+
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestAuthMiddleware_InvalidToken(t *testing.T) {
+	app, _ := setupAuthApp(t)
+
+	status, _ := doRequest(t, app, http.MethodGet, "/users/me", map[string]string{"Authorization": "Bearer not-a-jwt"})
+
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestAuthMiddleware_TokenFromAnotherIssuer(t *testing.T) {
+	app, _ := setupAuthApp(t)
+	other, err := security.NewJWTTokenService(&config.AuthConfig{
+		JWTAlgorithm:   "HS256",
+		JWTSecret:      "another-secret-with-at-least-32-bytes",
+		JWTIssuer:      "go-hexagonal",
+		AccessTokenTTL: time.Minute,
+	})
+	require.NoError(t, err)
+	token, _ := other.Issue(ports.TokenClaims{Subject: "user-1", TenantID: "tenant-123"})
+
+	status, _ := doRequest(t, app, http.MethodGet, "/users/me", map[string]string{"Authorization": "Bearer " + token})
+
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestAuthMiddleware_AnonymousPublicRoutes(t *testing.T) {
+	app, _ := setupAuthApp(t)
+
+	status, _ := doRequest(t, app, http.MethodGet, "/health", nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	// La alta de usuarios es anónima pero sigue exigiendo el tenant por cabecera
+	status, body := doRequest(t, app, http.MethodPost, "/users", map[string]string{"X-Tenant-Id": "tenant-123"})
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "tenant-123|", body)
+
+	// El método también forma parte de la ruta pública
+	status, _ = doRequest(t, app, http.MethodGet, "/users", map[string]string{"X-Tenant-Id": "tenant-123"})
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
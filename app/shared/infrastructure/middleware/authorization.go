@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/gofiber/fiber/v2"
+)
+
+var ErrPermissionDenied = exceptions.NewForbiddenError("permission denied", "")
+
+// RequirePermission rechaza la petición si el rol autenticado no concede el
+// permiso. Debe ejecutarse después de AuthMiddleware.
+func RequirePermission(permission authorization.Permission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !PrincipalFrom(c).Can(permission) {
+			return ErrPermissionDenied
+		}
+		return c.Next()
+	}
+}
+
+// PrincipalFrom construye el principal autenticado a partir de c.Locals. En
+// rutas anónimas devuelve un principal sin rol ni permisos.
+func PrincipalFrom(c *fiber.Ctx) authorization.Principal {
+	userID, _ := c.Locals("user_id").(string)
+	tenantID, _ := c.Locals("tenant_id").(string)
+	role, _ := c.Locals("role").(string)
+
+	return authorization.Principal{
+		UserID:   userID,
+		TenantID: tenantID,
+		Role:     authorization.Role(role),
+	}
+}
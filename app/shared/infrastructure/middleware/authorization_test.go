@@ -0,0 +1,48 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func setupPermissionApp(role string) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: middleware.ErrorHandler(zap.NewNop())})
+	app.Use(func(c *fiber.Ctx) error {
+		if role != "" {
+			c.Locals("role", role)
+		}
+		return c.Next()
+	})
+	ok := func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) }
+	app.Get("/users", middleware.RequirePermission(authorization.PermissionUsersList), ok)
+	app.Get("/users/me", middleware.RequirePermission(authorization.PermissionUsersRead), ok)
+	return app
+}
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name   string
+		role   string
+		path   string
+		status int
+	}{
+		{"admin can list", "tenant_admin", "/users", http.StatusOK},
+		{"member cannot list", "member", "/users", http.StatusForbidden},
+		{"member can read", "member", "/users/me", http.StatusOK},
+		{"unknown role has no permissions", "superuser", "/users/me", http.StatusForbidden},
+		{"anonymous has no permissions", "", "/users/me", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, _ := doRequest(t, setupPermissionApp(tt.role), http.MethodGet, tt.path, nil)
+			assert.Equal(t, tt.status, status)
+		})
+	}
+}
@@ -1,55 +1,96 @@
 package middleware
 
 import (
-	"sync"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
 	"time"
 
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/ratelimit"
 	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
 )
 
+var ErrRateLimitExceeded = shared_exceptions.NewTooManyRequestsError("rate limit exceeded", "")
+
+// storeTimeout acota la espera al store; pasado ese tiempo la petición sigue
+// sin límite.
+const storeTimeout = 500 * time.Millisecond
+
+// RateLimiter limita las peticiones de cada tenant. Se crea una sola vez: las
+// rutas comparten el cupo del tenant, y con un store compartido también lo
+// comparten todas las réplicas.
 type RateLimiter struct {
-	requests map[string]*tenantLimiter
-	mu       sync.RWMutex
+	store  ratelimit.Store
+	window time.Duration
+	logger *zap.Logger
 }
 
-type tenantLimiter struct {
-	count     int
-	resetTime time.Time
+func NewRateLimiter(store ratelimit.Store, cfg *config.RateLimitConfig, logger *zap.Logger) *RateLimiter {
+	return &RateLimiter{
+		store:  store,
+		window: cfg.Window,
+		logger: logger,
+	}
 }
 
-func RateLimiterMiddleware(cfg *config.Config, maxRequests int) fiber.Handler {
-	limiter := &RateLimiter{
-		requests: make(map[string]*tenantLimiter),
-	}
+// Limit permite maxRequests peticiones por ventana y tenant, en ráfaga o
+// repartidas. Todas las rutas comparten el cupo del tenant. Las respuestas
+// llevan las cabeceras RateLimit-*; un 429 lleva además Retry-After.
+func (l *RateLimiter) Limit(maxRequests int) fiber.Handler {
+	return l.limit(maxRequests, func(c *fiber.Ctx, tenantID string) string {
+		return tenantID
+	})
+}
 
-	return func(c *fiber.Ctx) error {
-		tenantID := c.Locals("tenant_id").(string)
+// LimitPerClient limita por tenant e IP del cliente. Es para las rutas sin
+// autenticar (login, refresh): un cliente anónimo no puede agotar el cupo de
+// los demás usuarios del tenant ni el del resto de la API.
+func (l *RateLimiter) LimitPerClient(maxRequests int) fiber.Handler {
+	return l.limit(maxRequests, func(c *fiber.Ctx, tenantID string) string {
+		return fmt.Sprintf("%s:ip:%s", tenantID, c.IP())
+	})
+}
 
-		limiter.mu.Lock()
+func (l *RateLimiter) limit(maxRequests int, bucket func(c *fiber.Ctx, tenantID string) string) fiber.Handler {
+	policy := ratelimit.Policy{Limit: maxRequests, Window: l.window}
+	policyHeader := fmt.Sprintf("%d;w=%d", maxRequests, seconds(l.window))
 
-		now := time.Now()
-		tl, exists := limiter.requests[tenantID]
+	return func(c *fiber.Ctx) error {
+		tenantID, _ := c.Locals("tenant_id").(string)
+		key := fmt.Sprintf("%s:%s", bucket(c, tenantID), policyHeader)
 
-		if !exists || now.After(tl.resetTime) {
-			limiter.requests[tenantID] = &tenantLimiter{
-				count:     1,
-				resetTime: now.Add(time.Minute),
-			}
-			limiter.mu.Unlock()
+		ctx, cancel := context.WithTimeout(c.UserContext(), storeTimeout)
+		decision, err := l.store.Allow(ctx, key, policy)
+		cancel()
+		if err != nil {
+			// Si el store no responde se deja pasar: mejor sin límite que sin API
+			l.logger.Warn("rate limiter unavailable",
+				zap.String("tenant_id", tenantID),
+				zap.Error(err),
+			)
 			return c.Next()
 		}
 
-		if tl.count >= maxRequests {
-			limiter.mu.Unlock()
-			return c.Status(429).JSON(fiber.Map{
-				"error": "rate limit exceeded",
-			})
-		}
+		c.Set("RateLimit-Policy", policyHeader)
+		c.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+		c.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		c.Set("RateLimit-Reset", strconv.Itoa(seconds(decision.ResetAfter)))
 
-		tl.count++
-		limiter.mu.Unlock()
+		if !decision.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(max(seconds(decision.RetryAfter), 1)))
+			return ErrRateLimitExceeded
+		}
 
 		return c.Next()
 	}
 }
+
+// seconds redondea hacia arriba: las cabeceras no deben invitar a reintentar
+// antes de tiempo.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
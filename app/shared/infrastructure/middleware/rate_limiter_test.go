@@ -0,0 +1,137 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/ratelimit"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupRateLimitApp(store ratelimit.Store) *fiber.App {
+	limiter := middleware.NewRateLimiter(store, &config.RateLimitConfig{Window: time.Minute}, zap.NewNop())
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler: middleware.ErrorHandler(zap.NewNop()),
+		ProxyHeader:  "X-Real-IP",
+	})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("tenant_id", c.Get("X-Tenant-Id"))
+		return c.Next()
+	})
+	app.Get("/users", limiter.Limit(2), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	app.Get("/tenants", limiter.Limit(2), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	app.Get("/login", limiter.LimitPerClient(1), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	return app
+}
+
+func clientRequest(t *testing.T, app *fiber.App, path, tenant, ip string) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("X-Tenant-Id", tenant)
+	req.Header.Set("X-Real-IP", ip)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	return resp
+}
+
+func limitedRequest(t *testing.T, app *fiber.App, path, tenant string) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("X-Tenant-Id", tenant)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestRateLimiter_SetsHeaders(t *testing.T) {
+	app := setupRateLimitApp(ratelimit.NewMemoryStore())
+
+	resp := limitedRequest(t, app, "/users", "acme")
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get("RateLimit-Limit"))
+	assert.Equal(t, "1", resp.Header.Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", resp.Header.Get("RateLimit-Reset"))
+	assert.Equal(t, "2;w=60", resp.Header.Get("RateLimit-Policy"))
+	assert.Empty(t, resp.Header.Get("Retry-After"))
+}
+
+func TestRateLimiter_RejectsWithApiError(t *testing.T) {
+	app := setupRateLimitApp(ratelimit.NewMemoryStore())
+
+	limitedRequest(t, app, "/users", "acme")
+	limitedRequest(t, app, "/users", "acme")
+	resp := limitedRequest(t, app, "/users", "acme")
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "0", resp.Header.Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", resp.Header.Get("Retry-After"))
+
+	var body map[string]any
+	raw, _ := io.ReadAll(resp.Body)
+	require.NoError(t, json.Unmarshal(raw, &body))
+	assert.Equal(t, float64(http.StatusTooManyRequests), body["code"])
+	assert.Equal(t, "rate limit exceeded", body["message"])
+}
+
+func TestRateLimiter_SharesQuotaAcrossRoutesPerTenant(t *testing.T) {
+	app := setupRateLimitApp(ratelimit.NewMemoryStore())
+
+	limitedRequest(t, app, "/users", "acme")
+	limitedRequest(t, app, "/tenants", "acme")
+
+	assert.Equal(t, http.StatusTooManyRequests, limitedRequest(t, app, "/users", "acme").StatusCode)
+	assert.Equal(t, http.StatusOK, limitedRequest(t, app, "/users", "globex").StatusCode)
+}
+
+func TestRateLimiter_PerClientLimitIsKeyedByIP(t *testing.T) {
+	app := setupRateLimitApp(ratelimit.NewMemoryStore())
+
+	assert.Equal(t, http.StatusOK, clientRequest(t, app, "/login", "acme", "10.0.0.1").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, clientRequest(t, app, "/login", "acme", "10.0.0.1").StatusCode)
+
+	// Otro cliente del mismo tenant conserva su cupo
+	assert.Equal(t, http.StatusOK, clientRequest(t, app, "/login", "acme", "10.0.0.2").StatusCode)
+}
+
+func TestRateLimiter_PerClientLimitDoesNotConsumeTenantQuota(t *testing.T) {
+	app := setupRateLimitApp(ratelimit.NewMemoryStore())
+
+	clientRequest(t, app, "/login", "acme", "10.0.0.1")
+	clientRequest(t, app, "/login", "acme", "10.0.0.1")
+
+	resp := limitedRequest(t, app, "/users", "acme")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("RateLimit-Remaining"))
+}
+
+type failingStore struct{}
+
+func (failingStore) Allow(ctx context.Context, key string, policy ratelimit.Policy) (ratelimit.Decision, error) {
+	return ratelimit.Decision{}, errors.New("connection refused")
+}
+
+func TestRateLimiter_FailsOpenWhenStoreIsUnavailable(t *testing.T) {
+	app := setupRateLimitApp(failingStore{})
+
+	resp := limitedRequest(t, app, "/users", "acme")
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("RateLimit-Limit"))
+}
@@ -1,18 +1,46 @@
 package middleware
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/gofiber/fiber/v2"
+)
 
-func TenantMiddleware() fiber.Handler {
+var (
+	ErrUnknownTenant   = exceptions.NewForbiddenError("unknown tenant", "")
+	ErrTenantSuspended = exceptions.NewForbiddenError("tenant suspended", "")
+)
+
+// TenantMiddleware resuelve el tenant de la petición y rechaza los que no
+// existen o están suspendidos.
+func TenantMiddleware(tenants shared_ports.TenantLookup) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		tenantID := c.Get("X-Tenant-Id")
+		// Con un token válido el tenant ya viene de sus claims
+		tenantID, ok := c.Locals("tenant_id").(string)
+		if !ok || tenantID == "" {
+			tenantID = c.Get("X-Tenant-Id")
+
+			if tenantID == "" {
+				return c.Status(400).JSON(fiber.Map{
+					"error": "X-Tenant-Id header is required",
+				})
+			}
 
-		if tenantID == "" {
-			return c.Status(400).JSON(fiber.Map{
-				"error": "X-Tenant-Id header is required",
-			})
+			c.Locals("tenant_id", tenantID)
 		}
 
-		c.Locals("tenant_id", tenantID)
-		return c.Next()
+		access, err := tenants.Access(c.UserContext(), tenantID)
+		if err != nil {
+			return err
+		}
+
+		switch access {
+		case shared_ports.TenantActive:
+			return c.Next()
+		case shared_ports.TenantSuspended:
+			return ErrTenantSuspended
+		default:
+			return ErrUnknownTenant
+		}
 	}
 }
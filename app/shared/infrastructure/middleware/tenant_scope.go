@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+// TenantContextMiddleware añade el tenant de la petición al contexto de los
+// controllers sin abrir transacción. Es para las rutas cuyos casos de uso
+// abren las suyas y deben confirmar cambios aunque la petición falle, como la
+// revocación de una familia de refresh tokens reutilizada.
+func TenantContextMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		tenantID, _ := c.Locals("tenant_id").(string)
+		c.SetUserContext(shared_ports.WithTenantID(c.UserContext(), tenantID))
+		return c.Next()
+	}
+}
+
+// TenantScopeMiddleware ejecuta el resto de la cadena dentro de una
+// transacción acotada al tenant de la petición. El contexto que reciben los
+// controllers (c.UserContext) lleva el tenant y la transacción, de modo que
+// toda lectura o escritura pasa por las políticas de row-level security.
+// Si el handler devuelve error la transacción se revierte.
+func TenantScopeMiddleware(txManager shared_ports.TransactionManager) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		tenantID, _ := c.Locals("tenant_id").(string)
+		ctx := shared_ports.WithTenantID(c.UserContext(), tenantID)
+
+		return txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			c.SetUserContext(ctx)
+			return c.Next()
+		})
+	}
+}
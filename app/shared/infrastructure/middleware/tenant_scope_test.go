@@ -0,0 +1,56 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/tenancy"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func setupTenantScopeApp(txManager *transaction.InMemoryTransactionManager) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: middleware.ErrorHandler(zap.NewNop())})
+	app.Use(middleware.TenantMiddleware(tenancy.NewInMemoryTenantLookup(ports.TenantActive)))
+	app.Use(middleware.TenantScopeMiddleware(txManager))
+
+	app.Get("/scope", func(c *fiber.Ctx) error {
+		tenantID, _ := ports.TenantIDFromContext(c.UserContext())
+		if !transaction.InTransaction(c.UserContext()) {
+			return c.SendString("no-tx")
+		}
+		return c.SendString(tenantID)
+	})
+	app.Get("/fail", func(c *fiber.Ctx) error {
+		return errors.New("boom")
+	})
+
+	return app
+}
+
+func TestTenantScopeMiddleware_RunsHandlerInTenantTransaction(t *testing.T) {
+	txManager := transaction.NewInMemoryTransactionManager()
+	app := setupTenantScopeApp(txManager)
+
+	status, body := doRequest(t, app, fiber.MethodGet, "/scope", map[string]string{"X-Tenant-Id": "tenant-123"})
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "tenant-123", body)
+	assert.Equal(t, 1, txManager.Commits())
+}
+
+func TestTenantScopeMiddleware_RollsBackOnError(t *testing.T) {
+	txManager := transaction.NewInMemoryTransactionManager()
+	app := setupTenantScopeApp(txManager)
+
+	status, _ := doRequest(t, app, fiber.MethodGet, "/fail", map[string]string{"X-Tenant-Id": "tenant-123"})
+
+	assert.Equal(t, http.StatusInternalServerError, status)
+	assert.Equal(t, 0, txManager.Commits())
+	assert.Equal(t, 1, txManager.Rollbacks())
+}
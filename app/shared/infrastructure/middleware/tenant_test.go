@@ -0,0 +1,51 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/middleware"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/tenancy"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func setupTenantApp() *fiber.App {
+	tenants := tenancy.NewInMemoryTenantLookup(ports.TenantUnknown)
+	tenants.Set("acme", ports.TenantActive)
+	tenants.Set("globex", ports.TenantSuspended)
+
+	app := fiber.New(fiber.Config{ErrorHandler: middleware.ErrorHandler(zap.NewNop())})
+	app.Use(middleware.TenantMiddleware(tenants))
+	app.Get("/users", func(c *fiber.Ctx) error {
+		return c.SendString(c.Locals("tenant_id").(string))
+	})
+	return app
+}
+
+func TestTenantMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		tenant string
+		status int
+	}{
+		"active":    {"acme", http.StatusOK},
+		"suspended": {"globex", http.StatusForbidden},
+		"unknown":   {"initech", http.StatusForbidden},
+		"missing":   {"", http.StatusBadRequest},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			headers := map[string]string{}
+			if tc.tenant != "" {
+				headers["X-Tenant-Id"] = tc.tenant
+			}
+
+			status, _ := doRequest(t, setupTenantApp(), http.MethodGet, "/users", headers)
+
+			assert.Equal(t, tc.status, status)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"gorm.io/gorm"
+)
+
+// GormOutboxEventBus no publica directamente en el broker: guarda el evento en
+// la tabla outbox_messages usando la transacción del contexto, de modo que el
+// evento se confirma junto con los cambios que lo originaron. El Relay se
+// encarga de entregarlo después.
+type GormOutboxEventBus struct {
+	db *gorm.DB
+}
+
+func NewGormOutboxEventBus(db *gorm.DB) *GormOutboxEventBus {
+	return &GormOutboxEventBus{db: db}
+}
+
+func (b *GormOutboxEventBus) Publish(ctx context.Context, event ports.DomainEvent, correlationID string) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return exceptions.NewBadRequestError("failed to marshal event", err.Error())
+	}
+
+	model := &OutboxMessageModel{
+		EventID:       event.EventID(),
+		EventType:     event.EventType(),
+		AggregateID:   event.AggregateID(),
+		Payload:       string(data),
+		CorrelationID: correlationID,
+		OccurredOn:    event.OccurredOn(),
+	}
+
+	if err := transaction.DB(ctx, b.db).Create(model).Error; err != nil {
+		return exceptions.NewInternalServerError("failed to store event in outbox", err.Error())
+	}
+
+	return nil
+}
+
+func (b *GormOutboxEventBus) Close() {}
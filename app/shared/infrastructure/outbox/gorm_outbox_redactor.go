@@ -0,0 +1,100 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
+	"gorm.io/gorm"
+)
+
+// GormOutboxRedactor reescribe los mensajes del outbox de un agregado, tanto
+// los pendientes como los ya publicados, en la transacción del contexto.
+type GormOutboxRedactor struct {
+	db *gorm.DB
+}
+
+func NewGormOutboxRedactor(db *gorm.DB) *GormOutboxRedactor {
+	return &GormOutboxRedactor{db: db}
+}
+
+func (r *GormOutboxRedactor) Redact(ctx context.Context, aggregateID string, fields map[string]any) error {
+	db := transaction.DB(ctx, r.db)
+
+	var messages []OutboxMessageModel
+	if err := db.Where("aggregate_id = ?", aggregateID).Find(&messages).Error; err != nil {
+		return exceptions.NewInternalServerError("failed to load outbox messages", err.Error())
+	}
+
+	for _, message := range messages {
+		payload, changed, err := redactPayload(message.Payload, fields)
+		if err != nil {
+			return exceptions.NewInternalServerError("failed to redact outbox message", err.Error())
+		}
+		if !changed {
+			continue
+		}
+
+		if err := db.Model(&OutboxMessageModel{}).
+			Where("id = ?", message.ID).
+			Update("payload", payload).Error; err != nil {
+			return exceptions.NewInternalServerError("failed to redact outbox message", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// redactPayload no añade a data campos que el evento no tenga, para no
+// alterar el contrato de su tipo.
+func redactPayload(payload string, fields map[string]any) (string, bool, error) {
+	var event map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return "", false, err
+	}
+
+	raw, ok := event["data"]
+	if !ok {
+		return payload, false, nil
+	}
+
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return "", false, err
+	}
+
+	changed := false
+	for key, value := range fields {
+		if _, ok := data[key]; !ok {
+			continue
+		}
+		changed = true
+
+		if value == nil {
+			delete(data, key)
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", false, err
+		}
+		data[key] = encoded
+	}
+	if !changed {
+		return payload, false, nil
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return "", false, err
+	}
+	event["data"] = encoded
+
+	redacted, err := json.Marshal(event)
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(redacted), true, nil
+}
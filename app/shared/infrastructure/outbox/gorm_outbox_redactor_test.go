@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var erasedFields = map[string]any{
+	"name":         "Deleted user",
+	"email":        "erased@example.invalid",
+	"display_name": nil,
+}
+
+func TestRedactPayload_ReplacesPersonalFields(t *testing.T) {
+	payload := `{"event_id":"e-1","event_type":"user.created","data":{"id":"u-1","name":"John Doe","email":"john@example.com","display_name":"Johnny","role":"member"}}`
+
+	redacted, changed, err := redactPayload(payload, erasedFields)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotContains(t, redacted, "John Doe")
+	assert.NotContains(t, redacted, "john@example.com")
+	assert.NotContains(t, redacted, "Johnny")
+
+	var event struct {
+		EventID string         `json:"event_id"`
+		Data    map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(redacted), &event))
+	assert.Equal(t, "e-1", event.EventID)
+	assert.Equal(t, "Deleted user", event.Data["name"])
+	assert.Equal(t, "erased@example.invalid", event.Data["email"])
+	assert.NotContains(t, event.Data, "display_name")
+	assert.Equal(t, "member", event.Data["role"])
+}
+
+func TestRedactPayload_DoesNotAddMissingFields(t *testing.T) {
+	// user.updated no lleva email y user.deleted no lleva datos personales
+	updated := `{"event_type":"user.updated","data":{"id":"u-1","name":"John Doe"}}`
+	redacted, changed, err := redactPayload(updated, erasedFields)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotContains(t, redacted, "email")
+
+	deleted := `{"event_type":"user.deleted","data":{"id":"u-1","deleted_at":"2024-01-01T00:00:00Z"}}`
+	redacted, changed, err = redactPayload(deleted, erasedFields)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, deleted, redacted)
+}
@@ -0,0 +1,23 @@
+package outbox
+
+import "time"
+
+type OutboxMessageModel struct {
+	ID            uint64     `gorm:"primaryKey;autoIncrement"`
+	EventID       string     `gorm:"type:varchar(100);not null;uniqueIndex:idx_outbox_event_id"`
+	EventType     string     `gorm:"type:varchar(100);not null"`
+	AggregateID   string     `gorm:"type:varchar(100);not null;index:idx_outbox_aggregate"`
+	Payload       string     `gorm:"type:jsonb;not null"`
+	CorrelationID string     `gorm:"type:varchar(100)"`
+	OccurredOn    time.Time  `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime;index:idx_outbox_created_at"`
+	PublishedAt   *time.Time `gorm:"index:idx_outbox_published_at"`
+	Attempts      int        `gorm:"not null;default:0"`
+	LastError     *string    `gorm:"type:text"`
+	NextAttemptAt *time.Time
+	FailedAt      *time.Time
+}
+
+func (OutboxMessageModel) TableName() string {
+	return "outbox_messages"
+}
@@ -0,0 +1,283 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// relayLockKey identifica el advisory lock de Postgres que garantiza que solo
+// un relay drena el outbox a la vez, preservando el orden por agregado.
+const relayLockKey = 7_246_001
+
+// maxRetryDelay acota el backoff entre intentos de un mismo mensaje.
+const maxRetryDelay = 5 * time.Minute
+
+var (
+	outboxLagSeconds = expvar.NewFloat("outbox_lag_seconds")
+	outboxPending    = expvar.NewInt("outbox_pending_messages")
+	outboxPublished  = expvar.NewInt("outbox_published_total")
+	outboxFailed     = expvar.NewInt("outbox_publish_failures_total")
+	outboxUnroutable = expvar.NewInt("outbox_unroutable_total")
+	outboxGivenUp    = expvar.NewInt("outbox_failed_messages_total")
+)
+
+// ConnectionSource enumera las bases de datos (o esquemas) con outbox propio.
+// Con tenancy compartida hay una sola.
+type ConnectionSource interface {
+	Connections(ctx context.Context) (map[string]*gorm.DB, error)
+}
+
+type Relay struct {
+	databases ConnectionSource
+	publisher ports.EventBus
+	cfg       *config.OutboxConfig
+	logger    *zap.Logger
+	stop      chan struct{}
+}
+
+func NewRelay(
+	databases ConnectionSource,
+	publisher ports.EventBus,
+	cfg *config.OutboxConfig,
+	logger *zap.Logger,
+) *Relay {
+	return &Relay{
+		databases: databases,
+		publisher: publisher,
+		cfg:       cfg,
+		logger:    logger,
+		stop:      make(chan struct{}),
+	}
+}
+
+func (r *Relay) Start(ctx context.Context) error {
+	r.logger.Info("outbox relay started",
+		zap.Duration("poll_interval", r.cfg.PollInterval),
+		zap.Int("batch_size", r.cfg.BatchSize),
+	)
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Si algún lote vino lleno seguimos drenando sin esperar al ticker
+		if r.drainAll(ctx) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) Stop() error {
+	select {
+	case <-r.stop:
+	default:
+		close(r.stop)
+	}
+	return nil
+}
+
+// drainAll drena un lote del outbox de cada base de datos e indica si alguno
+// vino lleno. El fallo de una base de datos no bloquea a las demás.
+func (r *Relay) drainAll(ctx context.Context) bool {
+	databases, err := r.databases.Connections(ctx)
+	if err != nil {
+		r.logger.Error("failed to resolve outbox databases", zap.Error(err))
+		return false
+	}
+
+	full := false
+	for tenantID, db := range databases {
+		published, err := r.drain(ctx, db)
+		if err != nil {
+			r.logger.Error("failed to drain outbox", zap.String("tenant_id", tenantID), zap.Error(err))
+			continue
+		}
+		full = full || published == r.cfg.BatchSize
+	}
+
+	r.recordLag(ctx, databases)
+
+	return full
+}
+
+// drain publica un lote de mensajes pendientes en orden de inserción. Si un
+// mensaje falla, él y los siguientes de su agregado esperan a next_attempt_at
+// (backoff exponencial) para no entregarlos fuera de orden; tras MaxAttempts
+// el mensaje queda como fallido y deja de bloquear el agregado. Cada marca se
+// confirma por separado, sin transacción abierta mientras se espera al broker.
+// Un mensaje publicado cuya marca no llega a guardarse se reenviará: la
+// entrega es at-least-once.
+func (r *Relay) drain(ctx context.Context, db *gorm.DB) (int, error) {
+	published := 0
+
+	err := db.WithContext(ctx).Connection(func(session *gorm.DB) error {
+		conn := session.Session(&gorm.Session{NewDB: true})
+
+		// Lock de sesión: dura todo el lote aunque cada marca se confirme aparte
+		var locked bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?)", relayLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		// Se suelta aunque el contexto esté cancelado: la conexión vuelve al pool
+		defer conn.WithContext(context.WithoutCancel(ctx)).Exec("SELECT pg_advisory_unlock(?)", relayLockKey)
+
+		var messages []OutboxMessageModel
+		if err := conn.
+			Where("published_at IS NULL AND failed_at IS NULL").
+			Where(`NOT EXISTS (
+				SELECT 1 FROM outbox_messages waiting
+				WHERE waiting.aggregate_id = outbox_messages.aggregate_id
+				  AND waiting.id <= outbox_messages.id
+				  AND waiting.published_at IS NULL
+				  AND waiting.failed_at IS NULL
+				  AND waiting.next_attempt_at > now()
+			)`).
+			Order("id").
+			Limit(r.cfg.BatchSize).
+			Find(&messages).Error; err != nil {
+			return err
+		}
+
+		blocked := make(map[string]bool)
+
+		for i := range messages {
+			msg := &messages[i]
+			if blocked[msg.AggregateID] {
+				continue
+			}
+
+			if err := r.publisher.Publish(ctx, storedEvent{message: msg}, msg.CorrelationID); err != nil {
+				blocked[msg.AggregateID] = true
+				if err := r.recordFailure(conn, msg, err); err != nil {
+					return err
+				}
+				continue
+			}
+
+			now := time.Now()
+			if err := conn.Model(msg).Updates(map[string]any{
+				"published_at": now,
+				"attempts":     gorm.Expr("attempts + 1"),
+			}).Error; err != nil {
+				return err
+			}
+			published++
+		}
+
+		return nil
+	})
+
+	outboxPublished.Add(int64(published))
+
+	return published, err
+}
+
+// recordFailure apunta el intento fallido y programa el siguiente, o marca el
+// mensaje como fallido si ya agotó los intentos.
+func (r *Relay) recordFailure(conn *gorm.DB, msg *OutboxMessageModel, cause error) error {
+	outboxFailed.Add(1)
+
+	// Sin cola enlazada el evento se reintenta igual: el consumidor declara su
+	// topología al arrancar y puede no haberlo hecho aún
+	failure := ports.PublishFailure("unknown")
+	var publishErr *ports.PublishError
+	if errors.As(cause, &publishErr) {
+		failure = publishErr.Failure
+	}
+	if failure == ports.PublishUnroutable {
+		outboxUnroutable.Add(1)
+	}
+
+	attempts := msg.Attempts + 1
+	updates := map[string]any{
+		"attempts":   attempts,
+		"last_error": cause.Error(),
+	}
+
+	if attempts >= r.cfg.MaxAttempts {
+		updates["failed_at"] = time.Now()
+		outboxGivenUp.Add(1)
+
+		r.logger.Error("outbox message failed, giving up",
+			zap.String("event_id", msg.EventID),
+			zap.String("aggregate_id", msg.AggregateID),
+			zap.String("failure", string(failure)),
+			zap.Int("attempts", attempts),
+			zap.Error(cause),
+		)
+	} else {
+		delay := retryDelay(r.cfg.RetryBaseDelay, attempts)
+		updates["next_attempt_at"] = time.Now().Add(delay)
+
+		r.logger.Warn("failed to publish outbox message",
+			zap.String("event_id", msg.EventID),
+			zap.String("aggregate_id", msg.AggregateID),
+			zap.String("failure", string(failure)),
+			zap.Int("attempts", attempts),
+			zap.Duration("retry_in", delay),
+			zap.Error(cause),
+		)
+	}
+
+	return conn.Model(msg).Updates(updates).Error
+}
+
+// retryDelay es la espera tras el intento n: base * 2^(n-1), con un máximo de
+// maxRetryDelay.
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// recordLag publica los pendientes de todas las bases de datos y el retraso
+// del mensaje más antiguo entre ellas.
+func (r *Relay) recordLag(ctx context.Context, databases map[string]*gorm.DB) {
+	var pending int64
+	lag := 0.0
+
+	for tenantID, db := range databases {
+		var stats struct {
+			Pending int64
+			Oldest  *time.Time
+		}
+
+		err := db.WithContext(ctx).
+			Model(&OutboxMessageModel{}).
+			Select("COUNT(*) AS pending, MIN(created_at) AS oldest").
+			Where("published_at IS NULL AND failed_at IS NULL").
+			Scan(&stats).Error
+		if err != nil {
+			r.logger.Warn("failed to compute outbox lag", zap.String("tenant_id", tenantID), zap.Error(err))
+			return
+		}
+
+		pending += stats.Pending
+		if stats.Oldest != nil {
+			lag = max(lag, time.Since(*stats.Oldest).Seconds())
+		}
+	}
+
+	outboxPending.Set(pending)
+	outboxLagSeconds.Set(lag)
+}
@@ -0,0 +1,162 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestRetryDelay_IsExponentialAndCapped(t *testing.T) {
+	assert.Equal(t, time.Second, retryDelay(time.Second, 1))
+	assert.Equal(t, 2*time.Second, retryDelay(time.Second, 2))
+	assert.Equal(t, 16*time.Second, retryDelay(time.Second, 5))
+	assert.Equal(t, maxRetryDelay, retryDelay(time.Second, 30))
+}
+
+// failingPublisher rechaza los eventos de los agregados indicados y apunta
+// cuántas veces se intentó publicar cada uno.
+type failingPublisher struct {
+	mu       sync.Mutex
+	failing  map[string]bool
+	attempts map[string]int
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, event ports.DomainEvent, correlationID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.attempts[event.EventID()]++
+	if p.failing[event.AggregateID()] {
+		return &ports.PublishError{EventID: event.EventID(), Failure: ports.PublishNacked, Err: errors.New("nacked")}
+	}
+	return nil
+}
+
+func (p *failingPublisher) Close() {}
+
+// RelayTestSuite drena el outbox contra Postgres real. Necesita
+// TEST_DATABASE_DSN apuntando a una base de datos desechable.
+type RelayTestSuite struct {
+	suite.Suite
+	db        *gorm.DB
+	publisher *failingPublisher
+	failing   string
+	healthy   string
+}
+
+func (suite *RelayTestSuite) SetupSuite() {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		suite.T().Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.db = db
+
+	migrator, err := migrations.NewMigrator(db)
+	suite.Require().NoError(err)
+	_, err = migrator.Up(context.Background())
+	suite.Require().NoError(err)
+}
+
+func (suite *RelayTestSuite) SetupTest() {
+	suite.failing = "relay-failing-" + uuid.NewString()
+	suite.healthy = "relay-healthy-" + uuid.NewString()
+	suite.publisher = &failingPublisher{
+		failing:  map[string]bool{suite.failing: true},
+		attempts: make(map[string]int),
+	}
+}
+
+func (suite *RelayTestSuite) TearDownTest() {
+	suite.db.Where("aggregate_id IN ?", []string{suite.failing, suite.healthy}).Delete(&OutboxMessageModel{})
+}
+
+func (suite *RelayTestSuite) relay(maxAttempts int) *Relay {
+	return NewRelay(nil, suite.publisher, &config.OutboxConfig{
+		BatchSize:      1000,
+		MaxAttempts:    maxAttempts,
+		RetryBaseDelay: time.Hour,
+	}, zap.NewNop())
+}
+
+func (suite *RelayTestSuite) enqueue(aggregateID string) *OutboxMessageModel {
+	msg := &OutboxMessageModel{
+		EventID:     uuid.NewString(),
+		EventType:   "user.updated",
+		AggregateID: aggregateID,
+		Payload:     `{}`,
+		OccurredOn:  time.Now(),
+	}
+	suite.Require().NoError(suite.db.Create(msg).Error)
+	return msg
+}
+
+func (suite *RelayTestSuite) reload(msg *OutboxMessageModel) OutboxMessageModel {
+	var model OutboxMessageModel
+	suite.Require().NoError(suite.db.First(&model, msg.ID).Error)
+	return model
+}
+
+func (suite *RelayTestSuite) TestFailedMessageBacksOffWithItsAggregate() {
+	first := suite.enqueue(suite.failing)
+	second := suite.enqueue(suite.failing)
+	other := suite.enqueue(suite.healthy)
+
+	relay := suite.relay(10)
+	_, err := relay.drain(context.Background(), suite.db)
+	suite.Require().NoError(err)
+
+	failed := suite.reload(first)
+	suite.Equal(1, failed.Attempts)
+	suite.Require().NotNil(failed.NextAttemptAt)
+	suite.True(failed.NextAttemptAt.After(time.Now()))
+	suite.Nil(suite.reload(second).PublishedAt)
+	suite.NotNil(suite.reload(other).PublishedAt)
+
+	// Durante el backoff ni el mensaje ni los siguientes de su agregado se leen
+	_, err = relay.drain(context.Background(), suite.db)
+	suite.Require().NoError(err)
+
+	suite.Equal(1, suite.publisher.attempts[first.EventID])
+	suite.Zero(suite.publisher.attempts[second.EventID])
+}
+
+func (suite *RelayTestSuite) TestMessageFailsAfterMaxAttempts() {
+	first := suite.enqueue(suite.failing)
+	second := suite.enqueue(suite.failing)
+
+	relay := suite.relay(1)
+	_, err := relay.drain(context.Background(), suite.db)
+	suite.Require().NoError(err)
+
+	failed := suite.reload(first)
+	suite.NotNil(failed.FailedAt)
+	suite.Nil(failed.PublishedAt)
+
+	// El mensaje fallido ya no bloquea a los siguientes de su agregado
+	delete(suite.publisher.failing, suite.failing)
+	_, err = relay.drain(context.Background(), suite.db)
+	suite.Require().NoError(err)
+
+	suite.Equal(1, suite.publisher.attempts[first.EventID])
+	suite.NotNil(suite.reload(second).PublishedAt)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
@@ -0,0 +1,30 @@
+package outbox
+
+import "time"
+
+// storedEvent adapta un mensaje del outbox a ports.DomainEvent. Al serializarse
+// devuelve el payload original, por lo que el formato en el broker es el mismo
+// que si el evento se hubiera publicado directamente.
+type storedEvent struct {
+	message *OutboxMessageModel
+}
+
+func (e storedEvent) EventID() string {
+	return e.message.EventID
+}
+
+func (e storedEvent) EventType() string {
+	return e.message.EventType
+}
+
+func (e storedEvent) AggregateID() string {
+	return e.message.AggregateID
+}
+
+func (e storedEvent) OccurredOn() time.Time {
+	return e.message.OccurredOn
+}
+
+func (e storedEvent) MarshalJSON() ([]byte, error) {
+	return []byte(e.message.Payload), nil
+}
@@ -9,7 +9,20 @@ import (
 )
 
 func ConnectDatabase(cfg *config.DBConfig) (*gorm.DB, error) {
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s TimeZone=UTC",
+	return openDatabase(databaseDSN(cfg), cfg, cfg.MaxOpenConns)
+}
+
+// ConnectControlPlaneDatabase abre un segundo pool sobre la base de datos
+// principal para las lecturas de control hechas en mitad de una petición. Si
+// compartieran pool con las transacciones de las peticiones, con todas las
+// conexiones ocupadas cada petición esperaría una segunda conexión que nunca
+// llega.
+func ConnectControlPlaneDatabase(cfg *config.DBConfig) (*gorm.DB, error) {
+	return openDatabase(databaseDSN(cfg), cfg, cfg.ControlPlaneMaxOpenConns)
+}
+
+func databaseDSN(cfg *config.DBConfig) string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s TimeZone=UTC",
 		cfg.Host,
 		cfg.Port,
 		cfg.User,
@@ -17,6 +30,11 @@ func ConnectDatabase(cfg *config.DBConfig) (*gorm.DB, error) {
 		cfg.Name,
 		cfg.SSLMode,
 	)
+}
+
+// openDatabase abre un pool con los tiempos de vida de cfg y como mucho
+// maxOpenConns conexiones.
+func openDatabase(dsn string, cfg *config.DBConfig, maxOpenConns int) (*gorm.DB, error) {
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
@@ -27,12 +45,13 @@ func ConnectDatabase(cfg *config.DBConfig) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to get database instance: %w", err)
 	}
 
-	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
-	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(min(cfg.MaxIdleConns, maxOpenConns))
+	sqlDB.SetMaxOpenConns(maxOpenConns)
 	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
 
 	if err := sqlDB.Ping(); err != nil {
+		sqlDB.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/transaction"
 	"gorm.io/gorm"
 )
 
@@ -19,7 +20,7 @@ func NewGormIdempotencyRepository(db *gorm.DB) *GormIdempotencyRepository {
 func (s *GormIdempotencyRepository) IsProcessed(ctx context.Context, tenantID, key string) (bool, error) {
 	var count int64
 
-	err := s.db.WithContext(ctx).
+	err := transaction.DB(ctx, s.db).
 		Model(&IdempotencyKeyModel{}).
 		Where("tenant_id = ? AND key = ?", tenantID, key).
 		Count(&count).Error
@@ -38,7 +39,7 @@ func (s *GormIdempotencyRepository) MarkAsProcessed(ctx context.Context, tenantI
 		ProcessedAt: time.Now(),
 	}
 
-	if err := s.db.WithContext(ctx).Create(model).Error; err != nil {
+	if err := transaction.DB(ctx, s.db).Create(model).Error; err != nil {
 		return fmt.Errorf("failed to mark as processed: %w", err)
 	}
 
@@ -0,0 +1,103 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func file(content string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(content)}
+}
+
+func TestLoad_OrdersByVersionAndPairsFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0002_add_column.up.sql":   file("ALTER TABLE t ADD c int"),
+		"sql/0002_add_column.down.sql": file("ALTER TABLE t DROP c"),
+		"sql/0001_create.up.sql":       file("CREATE TABLE t ()"),
+		"sql/0001_create.down.sql":     file("DROP TABLE t"),
+	}
+
+	migrations, err := load(fsys)
+
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, Migration{Version: 1, Name: "create", Up: "CREATE TABLE t ()", Down: "DROP TABLE t"}, migrations[0])
+	assert.Equal(t, 2, migrations[1].Version)
+	assert.Equal(t, "add_column", migrations[1].Name)
+}
+
+func TestLoad_DetectsControlPlaneMarker(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0001_create.up.sql":    file("CREATE TABLE t ()"),
+		"sql/0001_create.down.sql":  file("DROP TABLE t"),
+		"sql/0002_tenants.up.sql":   file("-- migrate:control-plane\nCREATE TABLE tenants ()"),
+		"sql/0002_tenants.down.sql": file("DROP TABLE tenants"),
+	}
+
+	migrations, err := load(fsys)
+
+	require.NoError(t, err)
+	assert.False(t, migrations[0].ControlPlane)
+	assert.True(t, migrations[1].ControlPlane)
+}
+
+func TestLoad_RequiresDownFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0001_create.up.sql": file("CREATE TABLE t ()"),
+	}
+
+	_, err := load(fsys)
+
+	assert.ErrorContains(t, err, "needs both up and down")
+}
+
+func TestLoad_RejectsDuplicateVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0001_create.up.sql":   file("CREATE TABLE t ()"),
+		"sql/0001_create.down.sql": file("DROP TABLE t"),
+		"sql/0001_other.up.sql":    file("SELECT 1"),
+	}
+
+	_, err := load(fsys)
+
+	assert.ErrorContains(t, err, "is used by")
+}
+
+func TestLoad_RejectsInvalidFileName(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/create_users.sql": file("CREATE TABLE t ()"),
+	}
+
+	_, err := load(fsys)
+
+	assert.ErrorContains(t, err, "invalid migration file name")
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := load(embedded)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	// Las versiones son consecutivas: un hueco suele ser un fichero renombrado
+	for i, migration := range migrations {
+		assert.Equal(t, i+1, migration.Version)
+	}
+}
+
+func TestEmbeddedMigrations_ControlPlaneTables(t *testing.T) {
+	migrations, err := load(embedded)
+	require.NoError(t, err)
+
+	var controlPlane []string
+	for _, migration := range migrations {
+		if migration.ControlPlane {
+			controlPlane = append(controlPlane, migration.Name)
+		}
+	}
+
+	assert.Equal(t, []string{"tenants", "feature_flags", "rate_limits"}, controlPlane)
+}
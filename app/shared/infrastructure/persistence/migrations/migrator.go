@@ -0,0 +1,239 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// advisoryLockKey identifica el lock de sesión que serializa a los procesos
+// que migran a la vez (por ejemplo, varias réplicas arrancando).
+const advisoryLockKey int64 = 0x676f686578
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    bigint PRIMARY KEY,
+	name       varchar(255) NOT NULL,
+	applied_at timestamptz  NOT NULL DEFAULT now()
+)`
+
+// Status describe una migración conocida por el binario. AppliedAt es nil si
+// está pendiente.
+type Status struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	Version   int
+	Name      string
+	AppliedAt time.Time
+}
+
+// Migrator aplica las migraciones SQL embebidas y registra las aplicadas en
+// schema_migrations. Cada migración corre en su propia transacción.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+
+	// tenant omite el SQL de las migraciones del plano de control
+	tenant bool
+}
+
+// NewMigrator migra la base de datos de control (o la única, con tenancy
+// compartida): aplica todas las migraciones.
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	return newMigrator(db, embedded, false)
+}
+
+// NewTenantMigrator migra el esquema o la base de datos de un tenant: las
+// migraciones del plano de control se registran sin ejecutarse.
+func NewTenantMigrator(db *gorm.DB) (*Migrator, error) {
+	return newMigrator(db, embedded, true)
+}
+
+func newMigrator(db *gorm.DB, fsys fs.FS, tenant bool) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations, tenant: tenant}, nil
+}
+
+// Latest es la versión de esquema que espera este binario.
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up aplica todas las migraciones pendientes y devuelve las aplicadas.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if m.runs(migration) {
+					if err := tx.Exec(migration.Up).Error; err != nil {
+						return err
+					}
+				}
+				return tx.Exec("INSERT INTO schema_migrations (version, name) VALUES (?, ?)", migration.Version, migration.Name).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Down revierte las últimas steps migraciones aplicadas, de la más reciente a
+// la más antigua.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		var versions []int
+		err := conn.Raw("SELECT version FROM schema_migrations ORDER BY version DESC LIMIT ?", steps).
+			Scan(&versions).Error
+		if err != nil {
+			return fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+
+		for _, version := range versions {
+			migration, ok := m.find(version)
+			if !ok {
+				return fmt.Errorf("migration %d is applied but unknown to this binary", version)
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if m.runs(migration) {
+					if err := tx.Exec(migration.Down).Error; err != nil {
+						return err
+					}
+				}
+				return tx.Exec("DELETE FROM schema_migrations WHERE version = ?", migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("rollback of %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Status lista las migraciones del binario y las aplicadas que no conoce.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn := m.db.WithContext(ctx)
+
+	applied := map[int]appliedMigration{}
+	if conn.Migrator().HasTable("schema_migrations") {
+		var err error
+		if applied, err = appliedVersions(conn); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &row.AppliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+
+	for _, row := range applied {
+		statuses = append(statuses, Status{Version: row.Version, Name: row.Name, AppliedAt: &row.AppliedAt})
+	}
+
+	return statuses, nil
+}
+
+// CheckVersion falla si la base de datos no está exactamente en la versión
+// que espera el binario: con migraciones pendientes el código fallaría en
+// runtime, y con una versión más nueva podría corromper datos.
+func (m *Migrator) CheckVersion(ctx context.Context) error {
+	conn := m.db.WithContext(ctx)
+
+	current := 0
+	if conn.Migrator().HasTable("schema_migrations") {
+		if err := conn.Raw("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current).Error; err != nil {
+			return fmt.Errorf("failed to read schema version: %w", err)
+		}
+	}
+
+	if current != m.Latest() {
+		return fmt.Errorf("database schema is at version %d but this build expects %d; run `migrate up`", current, m.Latest())
+	}
+
+	return nil
+}
+
+// runs indica si el SQL de la migración se ejecuta en esta base de datos.
+func (m *Migrator) runs(migration Migration) bool {
+	return !m.tenant || !migration.ControlPlane
+}
+
+func (m *Migrator) find(version int) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+// withLock ejecuta fn en una única conexión que mantiene el advisory lock, de
+// modo que dos procesos no migran a la vez.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", advisoryLockKey).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", advisoryLockKey)
+
+		if err := conn.Exec(createSchemaMigrations).Error; err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+
+		return fn(conn)
+	})
+}
+
+func appliedVersions(conn *gorm.DB) (map[int]appliedMigration, error) {
+	var rows []appliedMigration
+	if err := conn.Raw("SELECT version, name, applied_at FROM schema_migrations").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+
+	applied := make(map[int]appliedMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
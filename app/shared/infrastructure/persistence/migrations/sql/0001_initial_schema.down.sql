@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS user_email_index;
+DROP TABLE IF EXISTS event_store_snapshots;
+DROP TABLE IF EXISTS event_store;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS inbox_messages;
+DROP TABLE IF EXISTS outbox_messages;
+DROP TABLE IF EXISTS idempotency_keys;
+DROP TABLE IF EXISTS users_read;
+DROP TABLE IF EXISTS users;
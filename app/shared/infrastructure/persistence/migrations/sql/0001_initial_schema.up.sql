@@ -0,0 +1,132 @@
+-- Esquema base. Usa IF NOT EXISTS para poder adoptar bases de datos creadas
+-- por el antiguo AutoMigrate de desarrollo.
+
+CREATE TABLE IF NOT EXISTS users (
+    id           uuid PRIMARY KEY,
+    tenant_id    varchar(100) NOT NULL,
+    name         varchar(255) NOT NULL,
+    email        varchar(255) NOT NULL,
+    password     varchar(255) NOT NULL,
+    display_name varchar(255),
+    role         varchar(50)  NOT NULL DEFAULT 'member',
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_users_tenant ON users (tenant_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_email_per_tenant ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE IF NOT EXISTS users_read (
+    id           uuid         NOT NULL,
+    tenant_id    varchar(100) NOT NULL,
+    name         varchar(255) NOT NULL,
+    email        varchar(255) NOT NULL,
+    display_name varchar(255),
+    created_at   timestamptz  NOT NULL,
+    updated_at   timestamptz  NOT NULL,
+    deleted_at   timestamptz,
+    PRIMARY KEY (id, tenant_id)
+);
+
+-- users_read anterior a los timestamps tipados: created_at era varchar y no
+-- había updated_at
+DO $$
+BEGIN
+    IF (SELECT data_type FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'users_read' AND column_name = 'created_at') = 'character varying' THEN
+        ALTER TABLE users_read ALTER COLUMN created_at TYPE timestamptz USING created_at::timestamptz;
+        ALTER TABLE users_read ADD COLUMN IF NOT EXISTS updated_at timestamptz;
+        UPDATE users_read r SET updated_at = COALESCE(
+            (SELECT u.updated_at FROM users u WHERE u.id = r.id AND u.tenant_id = r.tenant_id),
+            r.created_at
+        ) WHERE r.updated_at IS NULL;
+        ALTER TABLE users_read ALTER COLUMN updated_at SET NOT NULL;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_read_tenant ON users_read (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_read_tenant_created ON users_read (tenant_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_read_deleted_at ON users_read (deleted_at);
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id           bigserial PRIMARY KEY,
+    tenant_id    varchar(100) NOT NULL,
+    key          varchar(255) NOT NULL,
+    processed_at timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_idem_key ON idempotency_keys (tenant_id, key);
+CREATE INDEX IF NOT EXISTS idx_idem_processed_at ON idempotency_keys (processed_at);
+
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id             bigserial PRIMARY KEY,
+    event_id       varchar(100) NOT NULL,
+    event_type     varchar(100) NOT NULL,
+    aggregate_id   varchar(100) NOT NULL,
+    payload        jsonb        NOT NULL,
+    correlation_id varchar(100),
+    occurred_on    timestamptz  NOT NULL,
+    created_at     timestamptz,
+    published_at   timestamptz,
+    attempts       bigint       NOT NULL DEFAULT 0,
+    last_error     text
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_outbox_event_id ON outbox_messages (event_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_aggregate ON outbox_messages (aggregate_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_created_at ON outbox_messages (created_at);
+CREATE INDEX IF NOT EXISTS idx_outbox_published_at ON outbox_messages (published_at);
+
+CREATE TABLE IF NOT EXISTS inbox_messages (
+    consumer     varchar(100) NOT NULL,
+    event_id     varchar(100) NOT NULL,
+    processed_at timestamptz  NOT NULL,
+    PRIMARY KEY (consumer, event_id)
+);
+CREATE INDEX IF NOT EXISTS idx_inbox_processed_at ON inbox_messages (processed_at);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id             uuid PRIMARY KEY,
+    tenant_id      varchar(100) NOT NULL,
+    user_id        uuid         NOT NULL,
+    family_id      uuid         NOT NULL,
+    token_hash     char(64)     NOT NULL,
+    expires_at     timestamptz  NOT NULL,
+    revoked_at     timestamptz,
+    replaced_by_id uuid,
+    created_at     timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_tenant_user ON refresh_tokens (tenant_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family ON refresh_tokens (family_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_hash ON refresh_tokens (token_hash);
+
+CREATE TABLE IF NOT EXISTS event_store (
+    id             bigserial PRIMARY KEY,
+    aggregate_type varchar(100) NOT NULL,
+    aggregate_id   varchar(100) NOT NULL,
+    version        bigint       NOT NULL,
+    tenant_id      varchar(100) NOT NULL,
+    event_type     varchar(100) NOT NULL,
+    payload        jsonb        NOT NULL,
+    occurred_at    timestamptz  NOT NULL,
+    created_at     timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_store_stream ON event_store (aggregate_type, aggregate_id, version);
+CREATE INDEX IF NOT EXISTS idx_event_store_tenant ON event_store (tenant_id);
+
+CREATE TABLE IF NOT EXISTS event_store_snapshots (
+    aggregate_type varchar(100) NOT NULL,
+    aggregate_id   varchar(100) NOT NULL,
+    tenant_id      varchar(100) NOT NULL,
+    version        bigint       NOT NULL,
+    state          jsonb        NOT NULL,
+    created_at     timestamptz,
+    PRIMARY KEY (aggregate_type, aggregate_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_email_index (
+    tenant_id varchar(100) NOT NULL,
+    email     varchar(255) NOT NULL,
+    user_id   uuid         NOT NULL,
+    PRIMARY KEY (tenant_id, email)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_email_index_user ON user_email_index (user_id);
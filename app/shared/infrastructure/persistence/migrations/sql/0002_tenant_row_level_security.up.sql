@@ -0,0 +1,33 @@
+-- Row-level security como segunda barrera de aislamiento entre tenants. La
+-- aplicación fija app.tenant_id con SET LOCAL en cada transacción de una
+-- petición; las filas de otro tenant quedan invisibles aunque la consulta
+-- olvide filtrar por tenant_id.
+--
+-- Sin app.tenant_id (procesos de sistema: consumers, relay del outbox,
+-- rebuild-projection, migraciones) no se filtra nada. Los superusuarios y los
+-- roles con BYPASSRLS se saltan las políticas: la aplicación debe conectar con
+-- un rol sin esos atributos para que apliquen.
+
+ALTER TABLE users ENABLE ROW LEVEL SECURITY;
+ALTER TABLE users FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON users
+    USING (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+           OR tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+                OR tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE users_read ENABLE ROW LEVEL SECURITY;
+ALTER TABLE users_read FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON users_read
+    USING (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+           OR tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+                OR tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE idempotency_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE idempotency_keys FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON idempotency_keys
+    USING (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+           OR tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+                OR tenant_id = current_setting('app.tenant_id', true));
@@ -0,0 +1,25 @@
+-- migrate:control-plane
+-- Registro de tenants. Vive en la base de datos principal (el plano de
+-- control en los modos de tenancy aislados).
+CREATE TABLE IF NOT EXISTS tenants (
+    id         varchar(100) PRIMARY KEY,
+    name       varchar(255) NOT NULL,
+    status     varchar(20)  NOT NULL,
+    plan       varchar(50)  NOT NULL,
+    created_at timestamptz  NOT NULL,
+    updated_at timestamptz  NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_tenants_status ON tenants (status);
+
+-- Hasta ahora cualquier X-Tenant-Id era válido: los tenants que ya tienen
+-- usuarios se dan de alta para que sigan operando
+INSERT INTO tenants (id, name, status, plan, created_at, updated_at)
+SELECT tenant_id, tenant_id, 'active', 'standard', COALESCE(MIN(created_at), now()), now()
+FROM users
+GROUP BY tenant_id
+ON CONFLICT (id) DO NOTHING;
+
+-- Tenant de los operadores de la plataforma, que administran el resto
+INSERT INTO tenants (id, name, status, plan, created_at, updated_at)
+VALUES ('platform', 'Platform', 'active', 'enterprise', now(), now())
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,27 @@
+-- migrate:control-plane
+-- Feature flags por tenant. Como el catálogo de tenants, viven en la base de
+-- datos principal.
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key         varchar(100) PRIMARY KEY,
+    description text         NOT NULL,
+    enabled     boolean      NOT NULL,
+    rollout     smallint     NOT NULL CHECK (rollout BETWEEN 0 AND 100),
+    created_at  timestamptz  NOT NULL,
+    updated_at  timestamptz  NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS feature_flag_overrides (
+    flag_key  varchar(100) NOT NULL REFERENCES feature_flags (key) ON DELETE CASCADE,
+    tenant_id varchar(100) NOT NULL,
+    enabled   boolean      NOT NULL,
+    PRIMARY KEY (flag_key, tenant_id)
+);
+
+-- display_name estaba activo para todos salvo tenant-50, fijado en el código
+INSERT INTO feature_flags (key, description, enabled, rollout, created_at, updated_at)
+VALUES ('display_name', 'Campo display_name en alta y edición de usuarios', true, 100, now(), now())
+ON CONFLICT (key) DO NOTHING;
+
+INSERT INTO feature_flag_overrides (flag_key, tenant_id, enabled)
+VALUES ('display_name', 'tenant-50', false)
+ON CONFLICT (flag_key, tenant_id) DO NOTHING;
@@ -0,0 +1,9 @@
+-- migrate:control-plane
+-- Estado del rate limiter compartido entre réplicas (GCRA: un instante por
+-- clave). UNLOGGED: tras una caída de Postgres los cupos empiezan de cero.
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limits (
+    key        varchar(255) PRIMARY KEY,
+    tat        timestamptz  NOT NULL,
+    allowed    boolean      NOT NULL,
+    updated_at timestamptz  NOT NULL
+);
@@ -0,0 +1,20 @@
+DROP POLICY IF EXISTS tenant_isolation ON users;
+CREATE POLICY tenant_isolation ON users
+    USING (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+           OR tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+                OR tenant_id = current_setting('app.tenant_id', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON users_read;
+CREATE POLICY tenant_isolation ON users_read
+    USING (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+           OR tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+                OR tenant_id = current_setting('app.tenant_id', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON idempotency_keys;
+CREATE POLICY tenant_isolation ON idempotency_keys
+    USING (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+           OR tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (NULLIF(current_setting('app.tenant_id', true), '') IS NULL
+                OR tenant_id = current_setting('app.tenant_id', true));
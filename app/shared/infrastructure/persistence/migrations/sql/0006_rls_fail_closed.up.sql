@@ -0,0 +1,21 @@
+-- Las políticas de 0002 dejaban ver todas las filas cuando app.tenant_id no
+-- estaba fijado. Ahora sin tenant no se ve ni se escribe nada: lo que corre
+-- fuera de una transacción de tenant por error falla en lugar de cruzar
+-- tenants. Los procesos de sistema que recorren todos los tenants
+-- (migraciones, rebuild-projection) conectan con un rol BYPASSRLS
+-- (DB_SYSTEM_USER).
+
+DROP POLICY IF EXISTS tenant_isolation ON users;
+CREATE POLICY tenant_isolation ON users
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON users_read;
+CREATE POLICY tenant_isolation ON users_read
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON idempotency_keys;
+CREATE POLICY tenant_isolation ON idempotency_keys
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_outbox_pending;
+ALTER TABLE outbox_messages DROP COLUMN IF EXISTS failed_at;
+ALTER TABLE outbox_messages DROP COLUMN IF EXISTS next_attempt_at;
@@ -0,0 +1,8 @@
+-- Reintentos del relay: un mensaje que falla espera hasta next_attempt_at (y
+-- con él los siguientes de su agregado). Agotados los intentos queda en
+-- failed_at y deja de bloquear el agregado.
+ALTER TABLE outbox_messages ADD COLUMN IF NOT EXISTS next_attempt_at timestamptz;
+ALTER TABLE outbox_messages ADD COLUMN IF NOT EXISTS failed_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox_messages (aggregate_id, id)
+    WHERE published_at IS NULL AND failed_at IS NULL;
@@ -0,0 +1,15 @@
+DROP POLICY IF EXISTS tenant_isolation ON user_email_index;
+ALTER TABLE user_email_index NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE user_email_index DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON event_store_snapshots;
+ALTER TABLE event_store_snapshots NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE event_store_snapshots DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON event_store;
+ALTER TABLE event_store NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE event_store DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON refresh_tokens;
+ALTER TABLE refresh_tokens NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE refresh_tokens DISABLE ROW LEVEL SECURITY;
@@ -0,0 +1,27 @@
+-- Completa el aislamiento de 0002/0006 con el resto de tablas por tenant:
+-- refresh tokens, event store (eventos y snapshots) e índice de emails del
+-- modo event sourced. Misma política: sin app.tenant_id no hay filas.
+
+ALTER TABLE refresh_tokens ENABLE ROW LEVEL SECURITY;
+ALTER TABLE refresh_tokens FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON refresh_tokens
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE event_store ENABLE ROW LEVEL SECURITY;
+ALTER TABLE event_store FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON event_store
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE event_store_snapshots ENABLE ROW LEVEL SECURITY;
+ALTER TABLE event_store_snapshots FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON event_store_snapshots
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE user_email_index ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_email_index FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON user_email_index
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
@@ -0,0 +1,248 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	shared_exceptions "github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTenantRequired = shared_exceptions.NewBadRequestError("tenant is required to resolve a database connection", "")
+	ErrUnknownTenant  = shared_exceptions.NewNotFoundError("unknown tenant", "")
+)
+
+// TenantConnectionResolver decide qué base de datos (o esquema) atiende a cada
+// tenant. Connections devuelve una conexión por cada destino gestionado,
+// indexada por tenant; en modo compartido hay una sola con clave "".
+type TenantConnectionResolver interface {
+	Resolve(ctx context.Context, tenantID string) (*gorm.DB, error)
+	Connections(ctx context.Context) (map[string]*gorm.DB, error)
+	Close() error
+}
+
+// NewTenantConnectionResolver construye el resolver del modo configurado en
+// cfg.Tenancy. db es la conexión principal: en modo compartido atiende a todos
+// los tenants y en los modos aislados es la base de datos de control.
+func NewTenantConnectionResolver(cfg *config.Config, db *gorm.DB) (TenantConnectionResolver, error) {
+	switch cfg.Tenancy.Mode {
+	case config.TenancyShared, "":
+		return NewSharedConnectionResolver(db), nil
+	case config.TenancySchema:
+		return NewSchemaConnectionResolver(&cfg.DB, db, cfg.Tenancy.Tenants, cfg.Tenancy.MaxOpenConns)
+	case config.TenancyDatabase:
+		return NewDatabaseConnectionResolver(&cfg.DB, db, cfg.Tenancy.Databases, cfg.Tenancy.MaxOpenConns)
+	default:
+		return nil, fmt.Errorf("unknown tenancy mode %q", cfg.Tenancy.Mode)
+	}
+}
+
+// SharedConnectionResolver atiende a todos los tenants con la misma base de
+// datos; el aislamiento lo dan tenant_id y las políticas de row-level security.
+type SharedConnectionResolver struct {
+	db *gorm.DB
+}
+
+func NewSharedConnectionResolver(db *gorm.DB) *SharedConnectionResolver {
+	return &SharedConnectionResolver{db: db}
+}
+
+func (r *SharedConnectionResolver) Resolve(ctx context.Context, tenantID string) (*gorm.DB, error) {
+	return r.db, nil
+}
+
+func (r *SharedConnectionResolver) Connections(ctx context.Context) (map[string]*gorm.DB, error) {
+	return map[string]*gorm.DB{"": r.db}, nil
+}
+
+// Close no cierra nada: la conexión compartida pertenece a quien la abrió.
+func (r *SharedConnectionResolver) Close() error {
+	return nil
+}
+
+// PooledConnectionResolver mantiene un pool por tenant, abierto la primera vez
+// que se necesita. Solo atiende a los tenants configurados; lo que no lleva
+// tenant (el catálogo de tenants, procesos de sistema) va a la base de datos
+// de control, si la hay.
+type PooledConnectionResolver struct {
+	cfg          *config.DBConfig
+	control      *gorm.DB
+	maxOpenConns int
+	dsns         map[string]string
+	prepare      func(db *gorm.DB, tenantID string) error
+
+	mu    sync.Mutex
+	conns map[string]*gorm.DB
+}
+
+var invalidSchemaChars = regexp.MustCompile(`[^a-z0-9_]`)
+
+// TenantSchema es el esquema de Postgres de un tenant en modo schema.
+func TenantSchema(tenantID string) string {
+	return "tenant_" + invalidSchemaChars.ReplaceAllString(strings.ToLower(tenantID), "_")
+}
+
+// NewSchemaConnectionResolver da a cada tenant un esquema propio dentro de la
+// base de datos de cfg. Cada pool fija search_path a ese esquema, así que los
+// repositorios no cambian; el esquema se crea al abrir el pool.
+func NewSchemaConnectionResolver(cfg *config.DBConfig, control *gorm.DB, tenants []string, maxOpenConns int) (*PooledConnectionResolver, error) {
+	if len(tenants) == 0 {
+		return nil, fmt.Errorf("schema tenancy requires at least one tenant")
+	}
+
+	dsns := make(map[string]string, len(tenants))
+	owners := make(map[string]string, len(tenants))
+	for _, tenantID := range tenants {
+		schema := TenantSchema(tenantID)
+		if len(schema) > 63 {
+			return nil, fmt.Errorf("schema %q for tenant %q exceeds 63 characters", schema, tenantID)
+		}
+		if owner, ok := owners[schema]; ok && owner != tenantID {
+			return nil, fmt.Errorf("tenants %q and %q map to the same schema %q", owner, tenantID, schema)
+		}
+		owners[schema] = tenantID
+		dsns[tenantID] = databaseDSN(cfg) + " search_path=" + schema
+	}
+
+	return newPooledConnectionResolver(cfg, control, dsns, maxOpenConns, func(db *gorm.DB, tenantID string) error {
+		schema := TenantSchema(tenantID)
+
+		// Postgres exige permiso CREATE aunque el esquema ya exista, y el rol
+		// de la aplicación no lo tiene: solo lo crean las migraciones
+		var exists bool
+		if err := db.Raw(`SELECT EXISTS (SELECT 1 FROM pg_namespace WHERE nspname = ?)`, schema).Scan(&exists).Error; err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+		return db.Exec(`CREATE SCHEMA IF NOT EXISTS "` + schema + `"`).Error
+	}), nil
+}
+
+// NewDatabaseConnectionResolver da a cada tenant su propia base de datos. dsns
+// asocia cada tenant a su DSN; cfg solo aporta los tiempos de vida del pool.
+func NewDatabaseConnectionResolver(cfg *config.DBConfig, control *gorm.DB, dsns map[string]string, maxOpenConns int) (*PooledConnectionResolver, error) {
+	if len(dsns) == 0 {
+		return nil, fmt.Errorf("database tenancy requires at least one tenant DSN")
+	}
+	for tenantID, dsn := range dsns {
+		if dsn == "" {
+			return nil, fmt.Errorf("tenant %q has an empty DSN", tenantID)
+		}
+	}
+
+	return newPooledConnectionResolver(cfg, control, dsns, maxOpenConns, nil), nil
+}
+
+func newPooledConnectionResolver(
+	cfg *config.DBConfig,
+	control *gorm.DB,
+	dsns map[string]string,
+	maxOpenConns int,
+	prepare func(db *gorm.DB, tenantID string) error,
+) *PooledConnectionResolver {
+	if maxOpenConns <= 0 {
+		maxOpenConns = cfg.MaxOpenConns
+	}
+
+	return &PooledConnectionResolver{
+		cfg:          cfg,
+		control:      control,
+		maxOpenConns: maxOpenConns,
+		dsns:         dsns,
+		prepare:      prepare,
+		conns:        make(map[string]*gorm.DB),
+	}
+}
+
+// Tenants lista, ordenados, los tenants que atiende el resolver.
+func (r *PooledConnectionResolver) Tenants() []string {
+	tenants := make([]string, 0, len(r.dsns))
+	for tenantID := range r.dsns {
+		tenants = append(tenants, tenantID)
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+func (r *PooledConnectionResolver) Resolve(ctx context.Context, tenantID string) (*gorm.DB, error) {
+	if tenantID == "" {
+		if r.control == nil {
+			return nil, ErrTenantRequired
+		}
+		return r.control, nil
+	}
+
+	dsn, ok := r.dsns[tenantID]
+	if !ok {
+		return nil, ErrUnknownTenant
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if db, ok := r.conns[tenantID]; ok {
+		return db, nil
+	}
+
+	db, err := openDatabase(dsn, r.cfg, r.maxOpenConns)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %s: %w", tenantID, err)
+	}
+
+	if r.prepare != nil {
+		if err := r.prepare(db.WithContext(ctx), tenantID); err != nil {
+			if sqlDB, err := db.DB(); err == nil {
+				sqlDB.Close()
+			}
+			return nil, fmt.Errorf("failed to prepare connection for tenant %s: %w", tenantID, err)
+		}
+	}
+
+	r.conns[tenantID] = db
+	return db, nil
+}
+
+func (r *PooledConnectionResolver) Connections(ctx context.Context) (map[string]*gorm.DB, error) {
+	conns := make(map[string]*gorm.DB, len(r.dsns)+1)
+	if r.control != nil {
+		conns[""] = r.control
+	}
+	for _, tenantID := range r.Tenants() {
+		db, err := r.Resolve(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		conns[tenantID] = db
+	}
+	return conns, nil
+}
+
+// Close cierra los pools de los tenants; la base de datos de control pertenece
+// a quien la abrió.
+func (r *PooledConnectionResolver) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs []error
+	for tenantID, db := range r.conns {
+		sqlDB, err := db.DB()
+		if err == nil {
+			err = sqlDB.Close()
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to close connection for tenant %s: %w", tenantID, err))
+		}
+	}
+	r.conns = make(map[string]*gorm.DB)
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,70 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTenantSchema(t *testing.T) {
+	assert.Equal(t, "tenant_acme", persistence.TenantSchema("acme"))
+	assert.Equal(t, "tenant_acme_corp_eu", persistence.TenantSchema("ACME-Corp.eu"))
+	assert.Equal(t, "tenant____drop", persistence.TenantSchema(`"; drop`))
+}
+
+func TestNewSchemaConnectionResolver_RejectsCollidingSchemas(t *testing.T) {
+	_, err := persistence.NewSchemaConnectionResolver(&config.DBConfig{}, nil, []string{"acme-eu", "acme_eu"}, 5)
+
+	assert.ErrorContains(t, err, "same schema")
+}
+
+func TestNewSchemaConnectionResolver_RequiresTenants(t *testing.T) {
+	_, err := persistence.NewSchemaConnectionResolver(&config.DBConfig{}, nil, nil, 5)
+
+	assert.Error(t, err)
+}
+
+func TestPooledConnectionResolver_RejectsUnknownOrMissingTenant(t *testing.T) {
+	resolver, err := persistence.NewDatabaseConnectionResolver(&config.DBConfig{}, nil, map[string]string{
+		"acme": "postgres://acme@db-acme/acme",
+	}, 5)
+	require.NoError(t, err)
+
+	_, err = resolver.Resolve(context.Background(), "globex")
+	assert.Equal(t, persistence.ErrUnknownTenant, err)
+
+	_, err = resolver.Resolve(context.Background(), "")
+	assert.Equal(t, persistence.ErrTenantRequired, err)
+
+	assert.Equal(t, []string{"acme"}, resolver.Tenants())
+}
+
+func TestPooledConnectionResolver_EmptyTenantUsesControlDatabase(t *testing.T) {
+	control := &gorm.DB{}
+	resolver, err := persistence.NewDatabaseConnectionResolver(&config.DBConfig{}, control, map[string]string{
+		"acme": "postgres://acme@db-acme/acme",
+	}, 5)
+	require.NoError(t, err)
+
+	db, err := resolver.Resolve(context.Background(), "")
+
+	require.NoError(t, err)
+	assert.Same(t, control, db)
+}
+
+func TestNewTenantConnectionResolver(t *testing.T) {
+	cfg := &config.Config{Tenancy: config.TenancyConfig{Mode: config.TenancyShared}}
+
+	resolver, err := persistence.NewTenantConnectionResolver(cfg, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &persistence.SharedConnectionResolver{}, resolver)
+
+	cfg.Tenancy.Mode = "cluster"
+	_, err = persistence.NewTenantConnectionResolver(cfg, nil)
+	assert.ErrorContains(t, err, "unknown tenancy mode")
+}
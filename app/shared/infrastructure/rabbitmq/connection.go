@@ -0,0 +1,61 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	domainEventsExchange = "domain_events"
+
+	reconnectBaseDelay = time.Second
+	reconnectMaxDelay  = 30 * time.Second
+)
+
+var ErrBrokerUnavailable = exceptions.NewServiceUnavailableError("message broker unavailable", "reconnecting to RabbitMQ")
+
+func dial(cfg *config.RabbitMQConfig) (*amqp.Connection, error) {
+	url := fmt.Sprintf(
+		"amqp://%s:%s@%s:%s%s",
+		cfg.User,
+		cfg.Password,
+		cfg.Host,
+		cfg.Port,
+		cfg.VHost,
+	)
+
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, exceptions.NewServiceUnavailableError("failed to connect to RabbitMQ", err.Error())
+	}
+	return conn, nil
+}
+
+func declareExchange(channel *amqp.Channel, name string) error {
+	if err := channel.ExchangeDeclare(
+		name,    // name
+		"topic", // type
+		true,    // durable
+		false,   // auto-deleted
+		false,   // internal
+		false,   // no-wait
+		nil,     // arguments
+	); err != nil {
+		return exceptions.NewServiceUnavailableError("failed to declare exchange", err.Error())
+	}
+	return nil
+}
+
+// reconnectDelay es el backoff exponencial entre intentos de reconexión,
+// acotado a reconnectMaxDelay.
+func reconnectDelay(attempt int) time.Duration {
+	delay := reconnectBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > reconnectMaxDelay {
+		return reconnectMaxDelay
+	}
+	return delay
+}
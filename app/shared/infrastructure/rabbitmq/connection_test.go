@@ -0,0 +1,65 @@
+package rabbitmq
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent struct{}
+
+func (testEvent) EventID() string       { return "event-1" }
+func (testEvent) EventType() string     { return "user.created" }
+func (testEvent) AggregateID() string   { return "user-1" }
+func (testEvent) OccurredOn() time.Time { return time.Time{} }
+
+func TestReconnectDelay_IsExponentialAndCapped(t *testing.T) {
+	assert.Equal(t, time.Second, reconnectDelay(1))
+	assert.Equal(t, 2*time.Second, reconnectDelay(2))
+	assert.Equal(t, 16*time.Second, reconnectDelay(5))
+	assert.Equal(t, reconnectMaxDelay, reconnectDelay(6))
+	assert.Equal(t, reconnectMaxDelay, reconnectDelay(100))
+}
+
+func TestRabbitMQEventBus_PublishWhileDisconnected(t *testing.T) {
+	bus := &RabbitMQEventBus{done: make(chan struct{})}
+
+	assert.Equal(t, ErrBrokerUnavailable, bus.Healthy())
+
+	err := bus.Publish(t.Context(), testEvent{}, "")
+
+	var publishErr *ports.PublishError
+	assert.True(t, errors.As(err, &publishErr))
+	assert.Equal(t, ports.PublishUnavailable, publishErr.Failure)
+	assert.Equal(t, "event-1", publishErr.EventID)
+	assert.ErrorIs(t, err, ErrBrokerUnavailable)
+}
+
+func TestPublisherSession_TakeReturn(t *testing.T) {
+	session := &publisherSession{
+		returned: make(map[string]amqp.Return),
+		barrier:  make(chan chan struct{}),
+		done:     make(chan struct{}),
+	}
+	returns := make(chan amqp.Return)
+	go session.trackReturns(returns)
+
+	returns <- amqp.Return{MessageId: "event-1", ReplyText: "NO_ROUTE"}
+
+	ret, ok := session.takeReturn("event-1")
+	assert.True(t, ok)
+	assert.Equal(t, "NO_ROUTE", ret.ReplyText)
+
+	_, ok = session.takeReturn("event-1")
+	assert.False(t, ok)
+
+	close(returns)
+	<-session.done
+
+	_, ok = session.takeReturn("event-2")
+	assert.False(t, ok)
+}
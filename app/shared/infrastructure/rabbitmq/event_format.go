@@ -0,0 +1,240 @@
+package rabbitmq
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Formatos de publicación. native es el sobre JSON propio (BaseEvent + data);
+// los de CloudEvents 1.0 siguen el binding AMQP de la especificación.
+const (
+	EventFormatNative                = "native"
+	EventFormatCloudEventsBinary     = "cloudevents-binary"
+	EventFormatCloudEventsStructured = "cloudevents-structured"
+)
+
+const (
+	cloudEventsSpecVersion  = "1.0"
+	cloudEventsContentType  = "application/cloudevents+json"
+	cloudEventsHeaderPrefix = "cloudEvents_"
+	jsonContentType         = "application/json"
+)
+
+// cloudEvent son los atributos CloudEvents de un evento de dominio. El
+// aggregate_id va en subject; versión, tenant y correlation ID son extensiones.
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	EventVersion    int             `json:"eventversion,omitempty"`
+	TenantID        string          `json:"tenantid,omitempty"`
+	CorrelationID   string          `json:"correlationid,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// nativeEnvelope es la parte del sobre nativo que no está en DomainEvent.
+type nativeEnvelope struct {
+	EventVersion int             `json:"event_version,omitempty"`
+	Data         json.RawMessage `json:"data"`
+}
+
+func validEventFormat(format string) bool {
+	switch format {
+	case EventFormatNative, EventFormatCloudEventsBinary, EventFormatCloudEventsStructured:
+		return true
+	default:
+		return false
+	}
+}
+
+// encodeEvent construye la publicación de un evento en el formato indicado.
+func encodeEvent(format, source string, event ports.DomainEvent, correlationID string) (amqp.Publishing, error) {
+	native, err := json.Marshal(event)
+	if err != nil {
+		return amqp.Publishing{}, exceptions.NewBadRequestError("failed to marshal event", err.Error())
+	}
+
+	publishing := amqp.Publishing{
+		Headers:       amqp.Table{headerAggregateID: event.AggregateID()},
+		ContentType:   jsonContentType,
+		Body:          native,
+		DeliveryMode:  amqp.Persistent,
+		CorrelationId: correlationID,
+		MessageId:     event.EventID(),
+	}
+
+	if format == EventFormatNative {
+		return publishing, nil
+	}
+
+	ce, err := newCloudEvent(source, event, correlationID, native)
+	if err != nil {
+		return amqp.Publishing{}, err
+	}
+
+	if format == EventFormatCloudEventsStructured {
+		body, err := json.Marshal(ce)
+		if err != nil {
+			return amqp.Publishing{}, exceptions.NewBadRequestError("failed to marshal cloud event", err.Error())
+		}
+		publishing.ContentType = cloudEventsContentType
+		publishing.Body = body
+		return publishing, nil
+	}
+
+	// Modo binario: atributos en cabeceras y el payload tal cual en el cuerpo
+	for name, value := range ce.attributes() {
+		publishing.Headers[cloudEventsHeaderPrefix+name] = value
+	}
+	publishing.ContentType = ce.DataContentType
+	publishing.Body = ce.Data
+
+	return publishing, nil
+}
+
+func newCloudEvent(source string, event ports.DomainEvent, correlationID string, native []byte) (*cloudEvent, error) {
+	var envelope nativeEnvelope
+	if err := json.Unmarshal(native, &envelope); err != nil {
+		return nil, exceptions.NewBadRequestError("failed to read event envelope", err.Error())
+	}
+
+	return &cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              event.EventID(),
+		Source:          source,
+		Type:            event.EventType(),
+		Subject:         event.AggregateID(),
+		Time:            event.OccurredOn().UTC().Format(time.RFC3339Nano),
+		DataContentType: jsonContentType,
+		EventVersion:    envelope.EventVersion,
+		TenantID:        tenantOf(envelope.Data),
+		CorrelationID:   correlationID,
+		Data:            envelope.Data,
+	}, nil
+}
+
+func (ce *cloudEvent) attributes() map[string]any {
+	attributes := map[string]any{
+		"specversion":     ce.SpecVersion,
+		"id":              ce.ID,
+		"source":          ce.Source,
+		"type":            ce.Type,
+		"datacontenttype": ce.DataContentType,
+	}
+	optional := map[string]string{
+		"subject":       ce.Subject,
+		"time":          ce.Time,
+		"tenantid":      ce.TenantID,
+		"correlationid": ce.CorrelationID,
+	}
+	for name, value := range optional {
+		if value != "" {
+			attributes[name] = value
+		}
+	}
+	if ce.EventVersion > 0 {
+		attributes["eventversion"] = int32(ce.EventVersion)
+	}
+	return attributes
+}
+
+// tenantOf extrae el tenant_id del payload de un evento, o "" si no lo lleva.
+func tenantOf(data []byte) string {
+	var tenant struct {
+		TenantID string `json:"tenant_id"`
+	}
+	_ = json.Unmarshal(data, &tenant)
+	return tenant.TenantID
+}
+
+// eventTenantID devuelve el tenant de un evento en formato nativo.
+func eventTenantID(native []byte) string {
+	var envelope nativeEnvelope
+	if err := json.Unmarshal(native, &envelope); err != nil {
+		return ""
+	}
+	return tenantOf(envelope.Data)
+}
+
+// decodeEvent devuelve el evento en formato nativo, que es lo que esperan los
+// handlers, sea cual sea el formato con el que se publicó.
+func decodeEvent(msg amqp.Delivery) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(msg.ContentType, cloudEventsContentType):
+		var ce cloudEvent
+		if err := json.Unmarshal(msg.Body, &ce); err != nil {
+			return nil, exceptions.NewBadRequestError("failed to unmarshal cloud event", err.Error())
+		}
+		return ce.native()
+
+	case msg.Headers[cloudEventsHeaderPrefix+"specversion"] != nil:
+		return cloudEventFromHeaders(msg).native()
+
+	default:
+		return msg.Body, nil
+	}
+}
+
+func cloudEventFromHeaders(msg amqp.Delivery) *cloudEvent {
+	header := func(name string) string {
+		value, _ := msg.Headers[cloudEventsHeaderPrefix+name].(string)
+		return value
+	}
+
+	ce := &cloudEvent{
+		SpecVersion:     header("specversion"),
+		ID:              header("id"),
+		Source:          header("source"),
+		Type:            header("type"),
+		Subject:         header("subject"),
+		Time:            header("time"),
+		DataContentType: msg.ContentType,
+		TenantID:        header("tenantid"),
+		CorrelationID:   header("correlationid"),
+		Data:            msg.Body,
+	}
+
+	switch v := msg.Headers[cloudEventsHeaderPrefix+"eventversion"].(type) {
+	case int32:
+		ce.EventVersion = int(v)
+	case int64:
+		ce.EventVersion = int(v)
+	}
+
+	return ce
+}
+
+// native reconstruye el sobre nativo a partir de los atributos CloudEvents.
+func (ce *cloudEvent) native() ([]byte, error) {
+	if ce.SpecVersion != cloudEventsSpecVersion {
+		return nil, exceptions.NewBadRequestError("unsupported cloud event", fmt.Sprintf("specversion %q is not supported", ce.SpecVersion))
+	}
+
+	envelope := map[string]any{
+		"event_id":     ce.ID,
+		"event_type":   ce.Type,
+		"aggregate_id": ce.Subject,
+		"data":         ce.Data,
+	}
+	if ce.Time != "" {
+		envelope["occurred_on"] = ce.Time
+	}
+	if ce.EventVersion > 0 {
+		envelope["event_version"] = ce.EventVersion
+	}
+	if len(ce.Data) == 0 {
+		envelope["data"] = nil
+	}
+
+	return json.Marshal(envelope)
+}
@@ -0,0 +1,136 @@
+package rabbitmq
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type formatTestEvent struct {
+	EventIDValue      string    `json:"event_id"`
+	EventTypeValue    string    `json:"event_type"`
+	EventVersionValue int       `json:"event_version"`
+	AggregateIDValue  string    `json:"aggregate_id"`
+	OccurredOnValue   time.Time `json:"occurred_on"`
+	Data              any       `json:"data"`
+}
+
+func (e formatTestEvent) EventID() string       { return e.EventIDValue }
+func (e formatTestEvent) EventType() string     { return e.EventTypeValue }
+func (e formatTestEvent) AggregateID() string   { return e.AggregateIDValue }
+func (e formatTestEvent) OccurredOn() time.Time { return e.OccurredOnValue }
+
+func newFormatTestEvent() formatTestEvent {
+	return formatTestEvent{
+		EventIDValue:      "event-1",
+		EventTypeValue:    "user.created",
+		EventVersionValue: 2,
+		AggregateIDValue:  "user-1",
+		OccurredOnValue:   time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC),
+		Data:              map[string]any{"id": "user-1", "tenant_id": "tenant-1"},
+	}
+}
+
+func deliveryFrom(p amqp.Publishing) amqp.Delivery {
+	return amqp.Delivery{
+		Headers:       p.Headers,
+		ContentType:   p.ContentType,
+		Body:          p.Body,
+		MessageId:     p.MessageId,
+		CorrelationId: p.CorrelationId,
+	}
+}
+
+func TestEncodeEvent_Native(t *testing.T) {
+	event := newFormatTestEvent()
+
+	publishing, err := encodeEvent(EventFormatNative, "/test", event, "corr-1")
+	require.NoError(t, err)
+
+	expected, _ := json.Marshal(event)
+	assert.Equal(t, jsonContentType, publishing.ContentType)
+	assert.JSONEq(t, string(expected), string(publishing.Body))
+	assert.Equal(t, "event-1", publishing.MessageId)
+	assert.Equal(t, "user-1", publishing.Headers[headerAggregateID])
+}
+
+func TestEncodeEvent_CloudEventsBinary(t *testing.T) {
+	publishing, err := encodeEvent(EventFormatCloudEventsBinary, "/test", newFormatTestEvent(), "corr-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, jsonContentType, publishing.ContentType)
+	assert.JSONEq(t, `{"id":"user-1","tenant_id":"tenant-1"}`, string(publishing.Body))
+	assert.Equal(t, "1.0", publishing.Headers["cloudEvents_specversion"])
+	assert.Equal(t, "event-1", publishing.Headers["cloudEvents_id"])
+	assert.Equal(t, "/test", publishing.Headers["cloudEvents_source"])
+	assert.Equal(t, "user.created", publishing.Headers["cloudEvents_type"])
+	assert.Equal(t, "user-1", publishing.Headers["cloudEvents_subject"])
+	assert.Equal(t, "2025-01-15T10:30:00Z", publishing.Headers["cloudEvents_time"])
+	assert.Equal(t, "tenant-1", publishing.Headers["cloudEvents_tenantid"])
+	assert.Equal(t, "corr-1", publishing.Headers["cloudEvents_correlationid"])
+	assert.Equal(t, int32(2), publishing.Headers["cloudEvents_eventversion"])
+	assert.NoError(t, publishing.Headers.Validate())
+}
+
+func TestEncodeEvent_CloudEventsStructured(t *testing.T) {
+	publishing, err := encodeEvent(EventFormatCloudEventsStructured, "/test", newFormatTestEvent(), "corr-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, cloudEventsContentType, publishing.ContentType)
+	assert.JSONEq(t, `{
+		"specversion": "1.0",
+		"id": "event-1",
+		"source": "/test",
+		"type": "user.created",
+		"subject": "user-1",
+		"time": "2025-01-15T10:30:00Z",
+		"datacontenttype": "application/json",
+		"eventversion": 2,
+		"tenantid": "tenant-1",
+		"correlationid": "corr-1",
+		"data": {"id": "user-1", "tenant_id": "tenant-1"}
+	}`, string(publishing.Body))
+}
+
+func TestDecodeEvent_RoundTripsEveryFormat(t *testing.T) {
+	event := newFormatTestEvent()
+	expected, _ := json.Marshal(event)
+
+	for _, format := range []string{EventFormatNative, EventFormatCloudEventsBinary, EventFormatCloudEventsStructured} {
+		t.Run(format, func(t *testing.T) {
+			publishing, err := encodeEvent(format, "/test", event, "corr-1")
+			require.NoError(t, err)
+
+			decoded, err := decodeEvent(deliveryFrom(publishing))
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(expected), string(decoded))
+		})
+	}
+}
+
+func TestEventTenantID(t *testing.T) {
+	native, _ := json.Marshal(newFormatTestEvent())
+
+	assert.Equal(t, "tenant-1", eventTenantID(native))
+	assert.Empty(t, eventTenantID([]byte(`{"data":{"id":"user-1"}}`)))
+	assert.Empty(t, eventTenantID([]byte(`not json`)))
+}
+
+func TestDecodeEvent_RejectsUnsupportedSpecVersion(t *testing.T) {
+	_, err := decodeEvent(amqp.Delivery{
+		ContentType: cloudEventsContentType,
+		Body:        []byte(`{"specversion":"0.3","id":"event-1","type":"user.created"}`),
+	})
+
+	assert.True(t, isPermanentError(err))
+}
+
+func TestValidEventFormat(t *testing.T) {
+	assert.True(t, validEventFormat(EventFormatCloudEventsBinary))
+	assert.False(t, validEventFormat("avro"))
+}
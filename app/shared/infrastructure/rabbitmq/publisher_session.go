@@ -0,0 +1,80 @@
+package rabbitmq
+
+import (
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// publisherSession es la conexión del publisher en modo confirm. Registra los
+// mensajes devueltos por mandatory para cruzarlos con su confirmación.
+type publisherSession struct {
+	conn    *amqp.Connection
+	channel *amqp.Channel
+
+	mu       sync.Mutex
+	returned map[string]amqp.Return
+
+	barrier chan chan struct{}
+	done    chan struct{}
+}
+
+func newPublisherSession(conn *amqp.Connection, channel *amqp.Channel) *publisherSession {
+	session := &publisherSession{
+		conn:     conn,
+		channel:  channel,
+		returned: make(map[string]amqp.Return),
+		barrier:  make(chan chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	go session.trackReturns(channel.NotifyReturn(make(chan amqp.Return)))
+
+	return session
+}
+
+func (s *publisherSession) trackReturns(returns <-chan amqp.Return) {
+	defer close(s.done)
+
+	for {
+		select {
+		case ret, ok := <-returns:
+			if !ok {
+				return
+			}
+			s.mu.Lock()
+			s.returned[ret.MessageId] = ret
+			s.mu.Unlock()
+		case flushed := <-s.barrier:
+			close(flushed)
+		}
+	}
+}
+
+// takeReturn indica si el broker devolvió el mensaje. El broker envía
+// basic.return antes que el ack, así que basta con esperar a que trackReturns
+// termine de registrar lo que ya recibió.
+func (s *publisherSession) takeReturn(messageID string) (amqp.Return, bool) {
+	flushed := make(chan struct{})
+	select {
+	case s.barrier <- flushed:
+		<-flushed
+	case <-s.done:
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret, ok := s.returned[messageID]
+	delete(s.returned, messageID)
+	return ret, ok
+}
+
+func (s *publisherSession) close() {
+	if !s.channel.IsClosed() {
+		s.channel.Close()
+	}
+	if !s.conn.IsClosed() {
+		s.conn.Close()
+	}
+}
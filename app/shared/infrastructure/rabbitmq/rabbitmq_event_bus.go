@@ -2,89 +2,201 @@ package rabbitmq
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
 	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
 	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
 )
 
 type RabbitMQEventBus struct {
-	conn    *amqp.Connection
-	channel *amqp.Channel
+	cfg    *config.RabbitMQConfig
+	logger *zap.Logger
+
+	// session es nil mientras no hay conexión con el broker
+	mu      sync.RWMutex
+	session *publisherSession
+
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
-func NewRabbitMQEventBus(cfg *config.RabbitMQConfig) (*RabbitMQEventBus, error) {
-	url := fmt.Sprintf(
-		"amqp://%s:%s@%s:%s%s",
-		cfg.User,
-		cfg.Password,
-		cfg.Host,
-		cfg.Port,
-		cfg.VHost,
-	)
+func NewRabbitMQEventBus(cfg *config.RabbitMQConfig, logger *zap.Logger) (*RabbitMQEventBus, error) {
+	if !validEventFormat(cfg.EventFormat) {
+		return nil, exceptions.NewBadRequestError("invalid event format", fmt.Sprintf("RABBITMQ_EVENT_FORMAT %q is not supported", cfg.EventFormat))
+	}
 
-	conn, err := amqp.Dial(url)
+	bus := &RabbitMQEventBus{
+		cfg:    cfg,
+		logger: logger,
+		done:   make(chan struct{}),
+	}
+
+	if err := bus.connect(); err != nil {
+		return nil, err
+	}
+
+	return bus, nil
+}
+
+func (b *RabbitMQEventBus) connect() error {
+	conn, err := dial(b.cfg)
 	if err != nil {
-		return nil, exceptions.NewServiceUnavailableError("failed to connect to RabbitMQ", err.Error())
+		return err
 	}
 
 	channel, err := conn.Channel()
 	if err != nil {
 		conn.Close()
-		return nil, exceptions.NewServiceUnavailableError("failed to open channel", err.Error())
+		return exceptions.NewServiceUnavailableError("failed to open channel", err.Error())
 	}
 
 	// Declarar exchange
-	if err := channel.ExchangeDeclare(
-		"domain_events", // name
-		"topic",         // type
-		true,            // durable
-		false,           // auto-deleted
-		false,           // internal
-		false,           // no-wait
-		nil,             // arguments
-	); err != nil {
-		channel.Close()
+	if err := declareExchange(channel, domainEventsExchange); err != nil {
+		conn.Close()
+		return err
+	}
+
+	// Modo confirm: el broker confirma cada publicación una vez persistida
+	if err := channel.Confirm(false); err != nil {
 		conn.Close()
-		return nil, exceptions.NewServiceUnavailableError("failed to declare exchange", err.Error())
+		return exceptions.NewServiceUnavailableError("failed to enable publisher confirms", err.Error())
 	}
 
-	return &RabbitMQEventBus{
-		conn:    conn,
-		channel: channel,
-	}, nil
+	connClosed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	channelClosed := channel.NotifyClose(make(chan *amqp.Error, 1))
+
+	b.mu.Lock()
+	b.session = newPublisherSession(conn, channel)
+	b.mu.Unlock()
+
+	go b.watch(connClosed, channelClosed)
+
+	return nil
+}
+
+// watch espera a que se cierre la conexión o el canal y reconecta con backoff.
+// Mientras tanto Publish falla con PublishUnavailable.
+func (b *RabbitMQEventBus) watch(connClosed, channelClosed chan *amqp.Error) {
+	var reason *amqp.Error
+	select {
+	case <-b.done:
+		return
+	case reason = <-connClosed:
+	case reason = <-channelClosed:
+	}
+
+	b.mu.Lock()
+	session := b.session
+	b.session = nil
+	b.mu.Unlock()
+
+	// Un canal cerrado por el broker deja la conexión abierta: se descarta entera
+	if session != nil {
+		session.close()
+	}
+
+	b.logger.Warn("RabbitMQ publisher connection lost", zap.Any("reason", reason))
+
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-b.done:
+			return
+		case <-time.After(reconnectDelay(attempt)):
+		}
+
+		if err := b.connect(); err != nil {
+			b.logger.Warn("RabbitMQ publisher reconnection failed",
+				zap.Int("attempt", attempt),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		b.logger.Info("RabbitMQ publisher reconnected", zap.Int("attempt", attempt))
+		return
+	}
 }
 
+// Publish publica con mandatory=true y espera el ack del broker. Devuelve un
+// *ports.PublishError si el evento no quedó persistido y enrutado.
 func (b *RabbitMQEventBus) Publish(ctx context.Context, event ports.DomainEvent, correlationID string) error {
-	data, err := json.Marshal(event)
+	b.mu.RLock()
+	session := b.session
+	b.mu.RUnlock()
+
+	if session == nil {
+		return publishError(event, ports.PublishUnavailable, ErrBrokerUnavailable)
+	}
+
+	publishing, err := encodeEvent(b.cfg.EventFormat, b.cfg.CloudEventsSource, event, correlationID)
 	if err != nil {
-		return exceptions.NewBadRequestError("failed to marshal event", err.Error())
+		return err
 	}
 
-	return b.channel.PublishWithContext(
+	confirmation, err := session.channel.PublishWithDeferredConfirmWithContext(
 		ctx,
-		"domain_events",   // exchange
-		event.EventType(), // routing key
-		false,             // mandatory
-		false,             // immediate
-		amqp.Publishing{
-			ContentType:   "application/json",
-			Body:          data,
-			DeliveryMode:  amqp.Persistent,
-			CorrelationId: correlationID,
-			MessageId:     event.EventID(),
-		},
+		domainEventsExchange, // exchange
+		event.EventType(),    // routing key
+		true,                 // mandatory
+		false,                // immediate
+		publishing,
 	)
+	if err != nil {
+		return publishError(event, ports.PublishUnavailable, err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, b.cfg.PublishConfirmTimeout)
+	defer cancel()
+
+	acked, err := confirmation.WaitContext(waitCtx)
+	if err != nil {
+		return publishError(event, ports.PublishUnconfirmed, err)
+	}
+	if !acked {
+		return publishError(event, ports.PublishNacked, errors.New("broker rejected the message"))
+	}
+
+	if ret, ok := session.takeReturn(event.EventID()); ok {
+		return publishError(event, ports.PublishUnroutable, errors.New(ret.ReplyText))
+	}
+
+	return nil
 }
 
-func (b *RabbitMQEventBus) Close() {
-	if b.channel != nil {
-		b.channel.Close()
+func publishError(event ports.DomainEvent, failure ports.PublishFailure, err error) *ports.PublishError {
+	return &ports.PublishError{
+		EventID:   event.EventID(),
+		EventType: event.EventType(),
+		Failure:   failure,
+		Err:       err,
+	}
+}
+
+// Healthy informa si hay conexión con el broker; lo consulta /ready.
+func (b *RabbitMQEventBus) Healthy() error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.session == nil || b.session.channel.IsClosed() {
+		return ErrBrokerUnavailable
 	}
-	if b.conn != nil {
-		b.conn.Close()
+	return nil
+}
+
+func (b *RabbitMQEventBus) Close() {
+	b.closeOnce.Do(func() { close(b.done) })
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.session != nil {
+		b.session.close()
+		b.session = nil
 	}
 }
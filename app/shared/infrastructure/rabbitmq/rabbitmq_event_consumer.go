@@ -2,7 +2,9 @@ package rabbitmq
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"sync"
+	"time"
 
 	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
 	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
@@ -12,14 +14,20 @@ import (
 )
 
 type RabbitMQConsumer struct {
-	conn       *amqp.Connection
-	channel    *amqp.Channel
 	cfg        *config.RabbitMQConfig
 	exchange   string
 	queueName  string
 	eventTypes []string
 	handler    ports.EventHandler
 	logger     *zap.Logger
+
+	// conn y channel se reemplazan en cada reconexión
+	mu      sync.Mutex
+	conn    *amqp.Connection
+	channel *amqp.Channel
+
+	stop     chan struct{}
+	stopOnce sync.Once
 }
 
 func NewRabbitMQConsumer(
@@ -37,53 +45,70 @@ func NewRabbitMQConsumer(
 		queueName:  queueName,
 		eventTypes: eventTypes,
 		handler:    handler,
+		stop:       make(chan struct{}),
 	}
 }
 
+// consumerSession agrupa lo que se pierde cuando cae la conexión.
+type consumerSession struct {
+	channel *amqp.Channel
+	msgs    <-chan amqp.Delivery
+	closed  chan *amqp.Error
+}
+
+// Start consume hasta que se cancela el contexto o se llama a Stop. Si el
+// broker cierra la conexión, reconecta con backoff, vuelve a declarar la
+// topología y reanuda el consumo. Solo el primer intento de conexión es fatal.
 func (c *RabbitMQConsumer) Start(ctx context.Context) error {
-	url := fmt.Sprintf(
-		"amqp://%s:%s@%s:%s%s",
-		c.cfg.User,
-		c.cfg.Password,
-		c.cfg.Host,
-		c.cfg.Port,
-		c.cfg.VHost,
-	)
+	session, err := c.connect()
+	if err != nil {
+		return err
+	}
+
+	c.logger.Info("RabbitMQ consumer started", zap.String("queue", c.queueName))
+
+	for {
+		if !c.consume(ctx, session) {
+			return c.Stop()
+		}
+
+		session = c.reconnect(ctx)
+		if session == nil {
+			return c.Stop()
+		}
+	}
+}
 
-	conn, err := amqp.Dial(url)
+func (c *RabbitMQConsumer) connect() (*consumerSession, error) {
+	conn, err := dial(c.cfg)
 	if err != nil {
-		return exceptions.NewServiceUnavailableError("failed to connect to RabbitMQ", err.Error())
+		return nil, err
 	}
-	c.conn = conn
 
 	channel, err := conn.Channel()
 	if err != nil {
-		return exceptions.NewServiceUnavailableError("failed to open channel", err.Error())
+		conn.Close()
+		return nil, exceptions.NewServiceUnavailableError("failed to open channel", err.Error())
 	}
-	c.channel = channel
 
-	queue, err := channel.QueueDeclare(
-		c.queueName, // name
-		true,        // durable
-		false,       // delete when unused
-		false,       // exclusive
-		false,       // no-wait
-		nil,         // arguments
-	)
+	queue, err := c.declareTopology(channel)
 	if err != nil {
-		return exceptions.NewServiceUnavailableError("failed to declare queue", err.Error())
+		conn.Close()
+		return nil, err
 	}
 
-	for _, eventType := range c.eventTypes {
-		if err := channel.QueueBind(
-			queue.Name,
-			eventType,
-			c.exchange,
-			false,
-			nil,
-		); err != nil {
-			return exceptions.NewServiceUnavailableError("failed to bind queue", err.Error())
-		}
+	// Modo confirm: los reenvíos a reintento o DLQ se confirman antes de hacer
+	// ack del original
+	if err := channel.Confirm(false); err != nil {
+		conn.Close()
+		return nil, exceptions.NewServiceUnavailableError("failed to enable publisher confirms", err.Error())
+	}
+
+	// Limita las entregas sin confirmar: el broker no empuja más de las que
+	// los workers pueden tener en curso
+	if err := channel.Qos(c.cfg.PrefetchCount, 0, false); err != nil {
+		conn.Close()
+		return nil, exceptions.NewServiceUnavailableError("failed to set prefetch", err.Error())
 	}
 
 	msgs, err := channel.Consume(
@@ -96,30 +121,100 @@ func (c *RabbitMQConsumer) Start(ctx context.Context) error {
 		nil,   // args
 	)
 	if err != nil {
-		return exceptions.NewServiceUnavailableError("failed to register consumer", err.Error())
+		conn.Close()
+		return nil, exceptions.NewServiceUnavailableError("failed to register consumer", err.Error())
 	}
 
-	c.logger.Info("RabbitMQ consumer started", zap.String("queue", c.queueName))
+	c.mu.Lock()
+	c.conn = conn
+	c.channel = channel
+	c.mu.Unlock()
+
+	return &consumerSession{
+		channel: channel,
+		msgs:    msgs,
+		closed:  conn.NotifyClose(make(chan *amqp.Error, 1)),
+	}, nil
+}
+
+// consume reparte los mensajes de la sesión entre los workers. Devuelve true
+// si la conexión se perdió y hay que reconectar, false si el consumidor debe
+// terminar. Antes de volver espera a que los workers acaben lo que tienen.
+func (c *RabbitMQConsumer) consume(ctx context.Context, session *consumerSession) bool {
+	workers := newWorkerPool(c.cfg.ConsumerWorkers, c.cfg.PrefetchCount, func(msg amqp.Delivery) {
+		c.handleDelivery(ctx, session.channel, msg)
+	})
+	defer workers.stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			return c.Stop()
-		case msg := <-msgs:
-			if err := c.processMessage(ctx, msg); err != nil {
-				msg.Nack(false, true) // requeue
-				c.logger.Error("x Error handling message", zap.Error(err))
-			} else {
-				msg.Ack(false)
-				c.logger.Info("✓ Message processed successfully")
+			return false
+		case <-c.stop:
+			return false
+		case reason := <-session.closed:
+			c.logger.Warn("RabbitMQ consumer connection lost",
+				zap.String("queue", c.queueName),
+				zap.Any("reason", reason),
+			)
+			return true
+		case msg, ok := <-session.msgs:
+			// El canal de entregas se cierra junto con el canal AMQP
+			if !ok {
+				c.logger.Warn("RabbitMQ consumer channel closed", zap.String("queue", c.queueName))
+				return true
 			}
+
+			workers.dispatch(aggregateIDOf(msg), msg)
+		}
+	}
+}
+
+func (c *RabbitMQConsumer) handleDelivery(ctx context.Context, channel *amqp.Channel, msg amqp.Delivery) {
+	if err := c.processMessage(ctx, msg); err != nil {
+		c.handleFailure(ctx, channel, msg, err)
+		return
+	}
+
+	msg.Ack(false)
+	c.logger.Info("✓ Message processed successfully")
+}
+
+// reconnect reintenta la conexión con backoff exponencial. Devuelve nil si el
+// consumidor se detuvo mientras esperaba.
+func (c *RabbitMQConsumer) reconnect(ctx context.Context) *consumerSession {
+	c.closeConnection()
+
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.stop:
+			return nil
+		case <-time.After(reconnectDelay(attempt)):
+		}
+
+		session, err := c.connect()
+		if err != nil {
+			c.logger.Warn("RabbitMQ consumer reconnection failed",
+				zap.String("queue", c.queueName),
+				zap.Int("attempt", attempt),
+				zap.Error(err),
+			)
+			continue
 		}
+
+		c.logger.Info("RabbitMQ consumer reconnected",
+			zap.String("queue", c.queueName),
+			zap.Int("attempt", attempt),
+		)
+		return session
 	}
 }
 
 func (c *RabbitMQConsumer) processMessage(ctx context.Context, msg amqp.Delivery) error {
 
-	eventType := msg.RoutingKey
+	eventType := eventTypeOf(msg)
 	eventQueue := c.queueName
 	correlationID := msg.CorrelationId
 	messageID := msg.MessageId
@@ -131,18 +226,163 @@ func (c *RabbitMQConsumer) processMessage(ctx context.Context, msg amqp.Delivery
 		zap.String("messageID", messageID),
 		zap.ByteString("body", msg.Body))
 
-	return c.handler.HandleEvent(ctx, eventType, msg.Body)
+	body, err := decodeEvent(msg)
+	if err != nil {
+		return err
+	}
+
+	ctx = ports.WithEventID(ctx, messageID)
+
+	// Con el tenant en el contexto el handler trabaja en la base de datos del
+	// tenant y bajo sus políticas de row-level security
+	if tenantID := eventTenantID(body); tenantID != "" {
+		ctx = ports.WithTenantID(ctx, tenantID)
+	}
+
+	return c.handler.HandleEvent(ctx, eventType, body)
+}
+
+// declareTopology declara el exchange, la cola principal con sus bindings, una
+// cola de reintento por intento (cuyo TTL devuelve el mensaje a la cola
+// principal a través del exchange por defecto) y la DLQ. Se repite en cada
+// reconexión porque el broker puede haber perdido las declaraciones.
+func (c *RabbitMQConsumer) declareTopology(channel *amqp.Channel) (amqp.Queue, error) {
+	if err := declareExchange(channel, c.exchange); err != nil {
+		return amqp.Queue{}, err
+	}
+
+	queue, err := channel.QueueDeclare(
+		c.queueName, // name
+		true,        // durable
+		false,       // delete when unused
+		false,       // exclusive
+		false,       // no-wait
+		nil,         // arguments
+	)
+	if err != nil {
+		return amqp.Queue{}, exceptions.NewServiceUnavailableError("failed to declare queue", err.Error())
+	}
+
+	for _, eventType := range c.eventTypes {
+		if err := channel.QueueBind(
+			queue.Name,
+			eventType,
+			c.exchange,
+			false,
+			nil,
+		); err != nil {
+			return amqp.Queue{}, exceptions.NewServiceUnavailableError("failed to bind queue", err.Error())
+		}
+	}
+
+	for attempt := 1; attempt <= c.cfg.MaxRetries; attempt++ {
+		if _, err := channel.QueueDeclare(
+			retryQueueName(c.queueName, attempt),
+			true,
+			false,
+			false,
+			false,
+			amqp.Table{
+				"x-message-ttl":             retryDelay(c.cfg.RetryBaseDelay, attempt).Milliseconds(),
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": c.queueName,
+			},
+		); err != nil {
+			return amqp.Queue{}, exceptions.NewServiceUnavailableError("failed to declare retry queue", err.Error())
+		}
+	}
+
+	if _, err := channel.QueueDeclare(deadLetterQueueName(c.queueName), true, false, false, false, nil); err != nil {
+		return amqp.Queue{}, exceptions.NewServiceUnavailableError("failed to declare dead-letter queue", err.Error())
+	}
+
+	return queue, nil
+}
+
+// handleFailure envía el mensaje a la siguiente cola de reintento o, si el
+// error es permanente o se agotaron los intentos, a la DLQ. El original solo
+// se confirma cuando el broker confirmó la copia; si la rechaza o no responde
+// a tiempo, el original vuelve a la cola.
+func (c *RabbitMQConsumer) handleFailure(ctx context.Context, channel *amqp.Channel, msg amqp.Delivery, cause error) {
+	retries := retryCount(msg.Headers)
+	target := deadLetterQueueName(c.queueName)
+
+	if !isPermanentError(cause) && retries < c.cfg.MaxRetries {
+		retries++
+		target = retryQueueName(c.queueName, retries)
+	}
+
+	if err := c.reroute(ctx, channel, target, republishing(msg, retries, cause)); err != nil {
+		c.logger.Error("x Failed to reroute message, requeueing",
+			zap.String("target", target),
+			zap.String("messageID", msg.MessageId),
+			zap.Error(err),
+		)
+		msg.Nack(false, true)
+		return
+	}
+
+	msg.Ack(false)
+
+	if target == deadLetterQueueName(c.queueName) {
+		c.logger.Error("x Message sent to dead-letter queue",
+			zap.String("queue", target),
+			zap.String("messageID", msg.MessageId),
+			zap.Int("retries", retries),
+			zap.Error(cause),
+		)
+		return
+	}
+
+	c.logger.Warn("x Error handling message, scheduled retry",
+		zap.String("queue", target),
+		zap.String("messageID", msg.MessageId),
+		zap.Int("attempt", retries),
+		zap.Error(cause),
+	)
+}
+
+// reroute publica la copia en la cola destino y espera su confirmación.
+func (c *RabbitMQConsumer) reroute(ctx context.Context, channel *amqp.Channel, target string, publishing amqp.Publishing) error {
+	confirmation, err := channel.PublishWithDeferredConfirmWithContext(ctx, "", target, false, false, publishing)
+	if err != nil {
+		return err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, c.cfg.PublishConfirmTimeout)
+	defer cancel()
+
+	acked, err := confirmation.WaitContext(waitCtx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return errors.New("broker rejected the message")
+	}
+
+	return nil
 }
 
 func (c *RabbitMQConsumer) Stop() error {
-	if c.channel != nil {
-		if err := c.channel.Close(); err != nil {
+	c.stopOnce.Do(func() { close(c.stop) })
+	return c.closeConnection()
+}
+
+func (c *RabbitMQConsumer) closeConnection() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	channel, conn := c.channel, c.conn
+	c.channel, c.conn = nil, nil
+
+	if channel != nil && !channel.IsClosed() {
+		if err := channel.Close(); err != nil {
 			return exceptions.NewInternalServerError("error closing channel", err.Error())
 		}
 	}
 
-	if c.conn != nil {
-		if err := c.conn.Close(); err != nil {
+	if conn != nil && !conn.IsClosed() {
+		if err := conn.Close(); err != nil {
 			return exceptions.NewInternalServerError("error closing connection", err.Error())
 		}
 	}
@@ -0,0 +1,88 @@
+package rabbitmq
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Cabeceras con las que el consumidor sigue el ciclo de reintentos. El tipo de
+// evento original se conserva porque al pasar por la cola de reintento el
+// mensaje vuelve con la routing key de la cola principal.
+const (
+	headerRetryCount = "x-retry-count"
+	headerEventType  = "x-original-routing-key"
+	headerLastError  = "x-last-error"
+	headerFailedAt   = "x-failed-at"
+)
+
+func retryQueueName(queue string, attempt int) string {
+	return fmt.Sprintf("%s.retry.%d", queue, attempt)
+}
+
+func deadLetterQueueName(queue string) string {
+	return queue + ".dlq"
+}
+
+// retryDelay calcula el backoff exponencial del intento n (empezando en 1).
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	return base << (attempt - 1)
+}
+
+// isPermanentError indica si reintentar no tiene sentido: payloads inválidos o
+// tipos de evento desconocidos (400/422). Los demás errores se reintentan; un
+// 404 puede deberse a eventos fuera de orden que se resuelven solos.
+func isPermanentError(err error) bool {
+	var apiErr *exceptions.ApiError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Code == 400 || apiErr.Code == 422
+}
+
+func retryCount(headers amqp.Table) int {
+	switch v := headers[headerRetryCount].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
+
+func eventTypeOf(msg amqp.Delivery) string {
+	if eventType, ok := msg.Headers[headerEventType].(string); ok && eventType != "" {
+		return eventType
+	}
+	return msg.RoutingKey
+}
+
+// republishing copia el mensaje para reenviarlo a una cola de reintento o a la
+// DLQ, conservando sus propiedades y añadiendo las cabeceras de seguimiento.
+func republishing(msg amqp.Delivery, attempts int, cause error) amqp.Publishing {
+	headers := amqp.Table{}
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	headers[headerRetryCount] = int32(attempts)
+	headers[headerEventType] = eventTypeOf(msg)
+	headers[headerLastError] = cause.Error()
+	headers[headerFailedAt] = time.Now().UTC().Format(time.RFC3339)
+
+	return amqp.Publishing{
+		Headers:       headers,
+		ContentType:   msg.ContentType,
+		DeliveryMode:  amqp.Persistent,
+		CorrelationId: msg.CorrelationId,
+		MessageId:     msg.MessageId,
+		Timestamp:     msg.Timestamp,
+		Type:          msg.Type,
+		Body:          msg.Body,
+	}
+}
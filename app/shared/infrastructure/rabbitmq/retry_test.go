@@ -0,0 +1,50 @@
+package rabbitmq
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay_IsExponential(t *testing.T) {
+	assert.Equal(t, time.Second, retryDelay(time.Second, 1))
+	assert.Equal(t, 2*time.Second, retryDelay(time.Second, 2))
+	assert.Equal(t, 16*time.Second, retryDelay(time.Second, 5))
+}
+
+func TestIsPermanentError(t *testing.T) {
+	assert.True(t, isPermanentError(exceptions.NewBadRequestError("unknown event type", "")))
+	assert.True(t, isPermanentError(fmt.Errorf("wrapped: %w", exceptions.NewValidationError("invalid", ""))))
+	assert.False(t, isPermanentError(exceptions.NewNotFoundError("user not found", "")))
+	assert.False(t, isPermanentError(exceptions.NewInternalServerError("db down", "")))
+	assert.False(t, isPermanentError(errors.New("connection reset")))
+}
+
+func TestRepublishing_KeepsOriginalEventType(t *testing.T) {
+	msg := amqp.Delivery{
+		RoutingKey:    "user.created",
+		MessageId:     "event-1",
+		CorrelationId: "corr-1",
+		Body:          []byte(`{}`),
+	}
+
+	first := republishing(msg, 1, errors.New("boom"))
+	assert.Equal(t, int32(1), first.Headers[headerRetryCount])
+	assert.Equal(t, "user.created", first.Headers[headerEventType])
+	assert.Equal(t, "boom", first.Headers[headerLastError])
+	assert.Equal(t, "event-1", first.MessageId)
+
+	// Tras pasar por la cola de reintento la routing key es la de la cola
+	redelivered := amqp.Delivery{RoutingKey: "user_projections", Headers: first.Headers}
+	assert.Equal(t, "user.created", eventTypeOf(redelivered))
+	assert.Equal(t, 1, retryCount(redelivered.Headers))
+
+	second := republishing(redelivered, 2, errors.New("boom again"))
+	assert.Equal(t, "user.created", second.Headers[headerEventType])
+	assert.Equal(t, int32(2), second.Headers[headerRetryCount])
+}
@@ -0,0 +1,76 @@
+package rabbitmq
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const headerAggregateID = "x-aggregate-id"
+
+// workerPool reparte las entregas entre workers según una clave: las entregas
+// con la misma clave las procesa siempre el mismo worker, en orden de llegada.
+type workerPool struct {
+	queues []chan amqp.Delivery
+	wg     sync.WaitGroup
+}
+
+func newWorkerPool(size, buffer int, process func(amqp.Delivery)) *workerPool {
+	if size < 1 {
+		size = 1
+	}
+
+	pool := &workerPool{queues: make([]chan amqp.Delivery, size)}
+
+	for i := range pool.queues {
+		queue := make(chan amqp.Delivery, buffer)
+		pool.queues[i] = queue
+
+		pool.wg.Add(1)
+		go func() {
+			defer pool.wg.Done()
+			for msg := range queue {
+				process(msg)
+			}
+		}()
+	}
+
+	return pool
+}
+
+func (p *workerPool) dispatch(key string, msg amqp.Delivery) {
+	p.queues[workerIndex(key, len(p.queues))] <- msg
+}
+
+// stop deja de aceptar entregas y espera a que los workers vacíen sus colas.
+func (p *workerPool) stop() {
+	for _, queue := range p.queues {
+		close(queue)
+	}
+	p.wg.Wait()
+}
+
+func workerIndex(key string, workers int) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(workers))
+}
+
+// aggregateIDOf obtiene la clave de orden de una entrega: la cabecera que pone
+// el publisher o, en mensajes anteriores a ella, el aggregate_id del payload.
+func aggregateIDOf(msg amqp.Delivery) string {
+	if aggregateID, ok := msg.Headers[headerAggregateID].(string); ok && aggregateID != "" {
+		return aggregateID
+	}
+
+	var payload struct {
+		AggregateID string `json:"aggregate_id"`
+	}
+	if err := json.Unmarshal(msg.Body, &payload); err == nil && payload.AggregateID != "" {
+		return payload.AggregateID
+	}
+
+	return msg.MessageId
+}
@@ -0,0 +1,56 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPool_PreservesOrderPerKey(t *testing.T) {
+	var mu sync.Mutex
+	processed := make(map[string][]string)
+
+	pool := newWorkerPool(4, 10, func(msg amqp.Delivery) {
+		key := aggregateIDOf(msg)
+		mu.Lock()
+		processed[key] = append(processed[key], msg.MessageId)
+		mu.Unlock()
+	})
+
+	expected := make(map[string][]string)
+	for i := 0; i < 50; i++ {
+		key := fmt.Sprintf("user-%d", i%5)
+		id := fmt.Sprintf("event-%d", i)
+		expected[key] = append(expected[key], id)
+		pool.dispatch(key, amqp.Delivery{
+			MessageId: id,
+			Headers:   amqp.Table{headerAggregateID: key},
+		})
+	}
+
+	pool.stop()
+
+	assert.Equal(t, expected, processed)
+}
+
+func TestWorkerIndex_IsStable(t *testing.T) {
+	assert.Equal(t, workerIndex("user-1", 8), workerIndex("user-1", 8))
+	assert.Equal(t, 0, workerIndex("user-1", 1))
+}
+
+func TestAggregateIDOf(t *testing.T) {
+	assert.Equal(t, "from-header", aggregateIDOf(amqp.Delivery{
+		Headers: amqp.Table{headerAggregateID: "from-header"},
+		Body:    []byte(`{"aggregate_id":"from-body"}`),
+	}))
+	assert.Equal(t, "from-body", aggregateIDOf(amqp.Delivery{
+		Body: []byte(`{"aggregate_id":"from-body"}`),
+	}))
+	assert.Equal(t, "event-1", aggregateIDOf(amqp.Delivery{
+		MessageId: "event-1",
+		Body:      []byte(`not json`),
+	}))
+}
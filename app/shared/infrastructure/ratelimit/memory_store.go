@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore guarda los cupos en memoria del proceso: cada réplica limita
+// por su cuenta. Sirve para una sola instancia y para tests.
+type MemoryStore struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	tats      map[string]time.Time
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		now:  time.Now,
+		tats: make(map[string]time.Time),
+	}
+}
+
+func (s *MemoryStore) Allow(ctx context.Context, key string, policy Policy) (Decision, error) {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now, policy.Window)
+
+	tat, allowed := gcra(policy, s.tats[key], now)
+	if allowed {
+		s.tats[key] = tat
+	}
+
+	return decide(policy, tat, now, allowed), nil
+}
+
+// sweep descarta, como mucho una vez por window, las claves con el cupo ya
+// lleno: equivalen a una clave nueva.
+func (s *MemoryStore) sweep(now time.Time, window time.Duration) {
+	if now.Sub(s.lastSweep) < window {
+		return
+	}
+	s.lastSweep = now
+
+	for key, tat := range s.tats {
+		if !tat.After(now) {
+			delete(s.tats, key)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestStore() (*MemoryStore, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	store.now = clock.Now
+	return store, clock
+}
+
+var perMinute = Policy{Limit: 3, Window: time.Minute}
+
+func TestMemoryStore_AllowsBurstUpToLimit(t *testing.T) {
+	store, _ := newTestStore()
+	ctx := context.Background()
+
+	for i := 2; i >= 0; i-- {
+		decision, err := store.Allow(ctx, "acme", perMinute)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, 3, decision.Limit)
+		assert.Equal(t, i, decision.Remaining)
+	}
+
+	decision, err := store.Allow(ctx, "acme", perMinute)
+
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, 0, decision.Remaining)
+	assert.Equal(t, 20*time.Second, decision.RetryAfter)
+	assert.Equal(t, time.Minute, decision.ResetAfter)
+}
+
+func TestMemoryStore_RefillsGradually(t *testing.T) {
+	store, clock := newTestStore()
+	ctx := context.Background()
+
+	for range 3 {
+		_, _ = store.Allow(ctx, "acme", perMinute)
+	}
+
+	// Un intervalo (window/limit) repone una sola petición
+	clock.Advance(20 * time.Second)
+
+	decision, _ := store.Allow(ctx, "acme", perMinute)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 0, decision.Remaining)
+
+	decision, _ = store.Allow(ctx, "acme", perMinute)
+	assert.False(t, decision.Allowed)
+}
+
+func TestMemoryStore_RejectedRequestsDoNotConsume(t *testing.T) {
+	store, clock := newTestStore()
+	ctx := context.Background()
+
+	for range 10 {
+		_, _ = store.Allow(ctx, "acme", perMinute)
+	}
+
+	clock.Advance(20 * time.Second)
+
+	decision, _ := store.Allow(ctx, "acme", perMinute)
+	assert.True(t, decision.Allowed)
+}
+
+func TestMemoryStore_KeysAreIndependent(t *testing.T) {
+	store, _ := newTestStore()
+	ctx := context.Background()
+
+	for range 3 {
+		_, _ = store.Allow(ctx, "acme", perMinute)
+	}
+
+	decision, _ := store.Allow(ctx, "globex", perMinute)
+
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 2, decision.Remaining)
+}
+
+func TestMemoryStore_EvictsFullBuckets(t *testing.T) {
+	store, clock := newTestStore()
+	ctx := context.Background()
+
+	_, _ = store.Allow(ctx, "acme", perMinute)
+	_, _ = store.Allow(ctx, "globex", perMinute)
+	require.Len(t, store.tats, 2)
+
+	clock.Advance(2 * time.Minute)
+	_, _ = store.Allow(ctx, "initech", perMinute)
+
+	assert.Len(t, store.tats, 1)
+	assert.Contains(t, store.tats, "initech")
+}
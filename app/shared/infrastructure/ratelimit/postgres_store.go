@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// cleanupInterval es cada cuánto una réplica borra las claves con el cupo ya
+// lleno.
+const cleanupInterval = time.Minute
+
+// allowQuery aplica GCRA en una sola sentencia: el upsert bloquea la fila, así
+// que dos réplicas no pueden consumir el mismo hueco. El reloj es el de
+// Postgres para que todas las réplicas usen el mismo.
+const allowQuery = `
+INSERT INTO rate_limits AS r (key, tat, allowed, updated_at)
+VALUES (@key, now() + make_interval(secs => @interval), true, now())
+ON CONFLICT (key) DO UPDATE SET
+    allowed = GREATEST(r.tat, EXCLUDED.updated_at) + make_interval(secs => @interval)
+              <= EXCLUDED.updated_at + make_interval(secs => @window),
+    tat = CASE
+              WHEN GREATEST(r.tat, EXCLUDED.updated_at) + make_interval(secs => @interval)
+                   <= EXCLUDED.updated_at + make_interval(secs => @window)
+              THEN GREATEST(r.tat, EXCLUDED.updated_at) + make_interval(secs => @interval)
+              ELSE r.tat
+          END,
+    updated_at = EXCLUDED.updated_at
+RETURNING tat, allowed, updated_at`
+
+// PostgresStore comparte los cupos entre réplicas en la tabla rate_limits de
+// la base de datos principal. No participa en la transacción de la petición:
+// un rechazo o un rollback no deben devolver el cupo consumido.
+type PostgresStore struct {
+	db *gorm.DB
+
+	mu          sync.Mutex
+	lastCleanup time.Time
+}
+
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+type allowRow struct {
+	Tat       time.Time
+	Allowed   bool
+	UpdatedAt time.Time
+}
+
+func (s *PostgresStore) Allow(ctx context.Context, key string, policy Policy) (Decision, error) {
+	s.cleanup(ctx)
+
+	var row allowRow
+	err := s.db.WithContext(ctx).Raw(allowQuery, map[string]any{
+		"key":      key,
+		"interval": policy.interval().Seconds(),
+		"window":   policy.Window.Seconds(),
+	}).Scan(&row).Error
+	if err != nil {
+		return Decision{}, fmt.Errorf("failed to update rate limit for %s: %w", key, err)
+	}
+
+	return decide(policy, row.Tat, row.UpdatedAt, row.Allowed), nil
+}
+
+// cleanup borra las claves cuyo tat ya pasó, que equivalen a una clave nueva.
+// Si falla, se reintenta en el siguiente intervalo.
+func (s *PostgresStore) cleanup(ctx context.Context) {
+	s.mu.Lock()
+	if time.Since(s.lastCleanup) < cleanupInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastCleanup = time.Now()
+	s.mu.Unlock()
+
+	s.db.WithContext(ctx).Exec("DELETE FROM rate_limits WHERE tat < now()")
+}
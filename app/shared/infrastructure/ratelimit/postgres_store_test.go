@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Necesita TEST_DATABASE_DSN apuntando a una base de datos desechable.
+func newPostgresStore(t *testing.T) *PostgresStore {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	migrator, err := migrations.NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	return NewPostgresStore(db)
+}
+
+func TestPostgresStore_AllowsBurstThenRejects(t *testing.T) {
+	store := newPostgresStore(t)
+	ctx := context.Background()
+	key := "test-" + uuid.NewString()
+
+	for i := 2; i >= 0; i-- {
+		decision, err := store.Allow(ctx, key, perMinute)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, i, decision.Remaining)
+	}
+
+	decision, err := store.Allow(ctx, key, perMinute)
+
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.InDelta(t, 20*time.Second, decision.RetryAfter, float64(time.Second))
+}
+
+func TestPostgresStore_ConcurrentRequestsShareQuota(t *testing.T) {
+	store := newPostgresStore(t)
+	ctx := context.Background()
+	key := "test-" + uuid.NewString()
+	policy := Policy{Limit: 5, Window: time.Hour}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		allowed int
+	)
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			decision, err := store.Allow(ctx, key, policy)
+			assert.NoError(t, err)
+			if decision.Allowed {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 5, allowed)
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Policy permite Limit peticiones por Window, con ráfagas de hasta Limit.
+type Policy struct {
+	Limit  int
+	Window time.Duration
+}
+
+// interval es el tiempo que tarda en reponerse una petición.
+func (p Policy) interval() time.Duration {
+	return p.Window / time.Duration(p.Limit)
+}
+
+// Decision es el resultado de consumir una petición de una clave.
+// ResetAfter es lo que falta para que el cupo vuelva a estar lleno y
+// RetryAfter, si la petición se rechaza, lo que falta para la siguiente.
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// Store guarda el estado de cada clave. Las implementaciones deben decidir de
+// forma atómica: varias réplicas pueden consumir la misma clave a la vez.
+type Store interface {
+	Allow(ctx context.Context, key string, policy Policy) (Decision, error)
+}
+
+// El algoritmo es GCRA, equivalente a un token bucket de capacidad Limit que
+// se rellena a Limit/Window, pero con un único instante por clave: tat
+// (theoretical arrival time), el momento en que el bucket vuelve a estar
+// lleno. Una petición cabe si, tras sumarle un intervalo, tat no queda más de
+// Window por delante de now.
+
+// gcra devuelve el nuevo tat y si la petición cabe. Si no cabe, tat no cambia.
+func gcra(policy Policy, tat, now time.Time) (time.Time, bool) {
+	if tat.Before(now) {
+		tat = now
+	}
+
+	next := tat.Add(policy.interval())
+	if next.Sub(now) > policy.Window {
+		return tat, false
+	}
+	return next, true
+}
+
+// decide calcula la respuesta a partir del tat resultante.
+func decide(policy Policy, tat, now time.Time, allowed bool) Decision {
+	interval := policy.interval()
+	ahead := max(tat.Sub(now), 0)
+
+	decision := Decision{
+		Allowed:    allowed,
+		Limit:      policy.Limit,
+		ResetAfter: ahead,
+	}
+
+	if allowed {
+		decision.Remaining = int((policy.Window - ahead) / interval)
+	} else {
+		decision.RetryAfter = max(ahead+interval-policy.Window, 0)
+	}
+
+	return decision
+}
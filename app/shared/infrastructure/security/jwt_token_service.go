@@ -0,0 +1,124 @@
+package security
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/exceptions"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minHMACSecretLength = 32
+
+var ErrInvalidAccessToken = exceptions.NewUnauthorizedError("invalid access token", "")
+
+type jwtClaims struct {
+	TenantID string `json:"tenant_id"`
+	Role     string `json:"role,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type JWTTokenService struct {
+	method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+	issuer    string
+	ttl       time.Duration
+}
+
+func NewJWTTokenService(cfg *config.AuthConfig) (*JWTTokenService, error) {
+	service := &JWTTokenService{
+		issuer: cfg.JWTIssuer,
+		ttl:    cfg.AccessTokenTTL,
+	}
+
+	switch cfg.JWTAlgorithm {
+	case "HS256":
+		if len(cfg.JWTSecret) < minHMACSecretLength {
+			return nil, fmt.Errorf("JWT_SECRET must be at least %d bytes for HS256", minHMACSecretLength)
+		}
+		service.method = jwt.SigningMethodHS256
+		service.signKey = []byte(cfg.JWTSecret)
+		service.verifyKey = []byte(cfg.JWTSecret)
+
+	case "RS256":
+		privateKey, err := loadRSAPrivateKey(cfg.JWTPrivateKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		service.method = jwt.SigningMethodRS256
+		service.signKey = privateKey
+		service.verifyKey = &privateKey.PublicKey
+
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", cfg.JWTAlgorithm)
+	}
+
+	return service, nil
+}
+
+func (s *JWTTokenService) Issue(claims ports.TokenClaims) (string, error) {
+	now := time.Now()
+
+	token := jwt.NewWithClaims(s.method, jwtClaims{
+		TenantID: claims.TenantID,
+		Role:     claims.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   claims.Subject,
+			Issuer:    s.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.ttl)),
+		},
+	})
+
+	return token.SignedString(s.signKey)
+}
+
+func (s *JWTTokenService) Parse(tokenString string) (*ports.TokenClaims, error) {
+	var claims jwtClaims
+
+	// Solo se acepta el algoritmo configurado para evitar ataques de confusión
+	_, err := jwt.ParseWithClaims(tokenString, &claims,
+		func(*jwt.Token) (any, error) { return s.verifyKey, nil },
+		jwt.WithValidMethods([]string{s.method.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || claims.Subject == "" || claims.TenantID == "" {
+		return nil, ErrInvalidAccessToken
+	}
+
+	return &ports.TokenClaims{
+		Subject:   claims.Subject,
+		TenantID:  claims.TenantID,
+		Role:      claims.Role,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+func (s *JWTTokenService) AccessTokenTTL() time.Duration {
+	return s.ttl
+}
+
+func loadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
+	if path == "" {
+		return nil, fmt.Errorf("JWT_PRIVATE_KEY_PATH is required for RS256")
+	}
+
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT private key: %w", err)
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT private key: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,40 @@
+package tenancy
+
+import (
+	"context"
+	"sync"
+
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+)
+
+// InMemoryTenantLookup implementa ports.TenantLookup sin base de datos. Está
+// pensado para tests: los tenants sin estado fijado con Set devuelven
+// fallback.
+type InMemoryTenantLookup struct {
+	mu       sync.Mutex
+	tenants  map[string]ports.TenantAccess
+	fallback ports.TenantAccess
+}
+
+func NewInMemoryTenantLookup(fallback ports.TenantAccess) *InMemoryTenantLookup {
+	return &InMemoryTenantLookup{
+		tenants:  make(map[string]ports.TenantAccess),
+		fallback: fallback,
+	}
+}
+
+func (l *InMemoryTenantLookup) Set(tenantID string, access ports.TenantAccess) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tenants[tenantID] = access
+}
+
+func (l *InMemoryTenantLookup) Access(ctx context.Context, tenantID string) (ports.TenantAccess, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if access, ok := l.tenants[tenantID]; ok {
+		return access, nil
+	}
+	return l.fallback, nil
+}
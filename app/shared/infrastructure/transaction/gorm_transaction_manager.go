@@ -0,0 +1,80 @@
+package transaction
+
+import (
+	"context"
+
+	shared_ports "github.com/carloscacb333/go-hexagonal/app/shared/domain/ports"
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// ConnectionResolver elige la base de datos de un tenant. Lo implementan los
+// resolvers de persistence según el modo de tenancy.
+type ConnectionResolver interface {
+	Resolve(ctx context.Context, tenantID string) (*gorm.DB, error)
+}
+
+type singleConnection struct {
+	db *gorm.DB
+}
+
+func (s singleConnection) Resolve(ctx context.Context, tenantID string) (*gorm.DB, error) {
+	return s.db, nil
+}
+
+type GormTransactionManager struct {
+	resolver ConnectionResolver
+}
+
+func NewGormTransactionManager(db *gorm.DB) *GormTransactionManager {
+	return &GormTransactionManager{resolver: singleConnection{db: db}}
+}
+
+// NewTenantGormTransactionManager abre cada transacción en la base de datos
+// que resolver asigna al tenant del contexto.
+func NewTenantGormTransactionManager(resolver ConnectionResolver) *GormTransactionManager {
+	return &GormTransactionManager{resolver: resolver}
+}
+
+// WithinTransaction ejecuta fn dentro de una transacción. Si el contexto ya
+// transporta una transacción, fn se une a ella en lugar de abrir otra.
+//
+// Si el contexto lleva un tenant, la transacción fija app.tenant_id con
+// alcance local para que las políticas de row-level security filtren por él.
+func (m *GormTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok && tx != nil {
+		return fn(ctx)
+	}
+
+	tenantID, hasTenant := shared_ports.TenantIDFromContext(ctx)
+
+	db, err := m.resolver.Resolve(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if hasTenant {
+			if err := tx.Exec("SELECT set_config('app.tenant_id', ?, true)", tenantID).Error; err != nil {
+				return err
+			}
+		}
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// DB devuelve la transacción activa del contexto o, si no existe, db.
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok && tx != nil {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// Detach devuelve un contexto sin la transacción activa. Lo usan las lecturas
+// de la base de datos de control hechas en mitad de una petición, cuya
+// transacción puede estar abierta en la base de datos de otro tenant.
+func Detach(ctx context.Context) context.Context {
+	return context.WithValue(ctx, txKey{}, (*gorm.DB)(nil))
+}
@@ -0,0 +1,63 @@
+package transaction
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+type memoryTxKey struct{}
+
+// InMemoryTransactionManager implementa ports.TransactionManager sin base de
+// datos. Está pensado para tests: ejecuta fn con un contexto marcado como
+// transaccional y cuenta commits y rollbacks.
+type InMemoryTransactionManager struct {
+	mu        sync.Mutex
+	commits   int
+	rollbacks int
+}
+
+func NewInMemoryTransactionManager() *InMemoryTransactionManager {
+	return &InMemoryTransactionManager{}
+}
+
+func (m *InMemoryTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	err := fn(context.WithValue(ctx, memoryTxKey{}, true))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		m.rollbacks++
+	} else {
+		m.commits++
+	}
+
+	return err
+}
+
+func (m *InMemoryTransactionManager) Commits() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.commits
+}
+
+func (m *InMemoryTransactionManager) Rollbacks() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rollbacks
+}
+
+// InTransaction indica si el contexto transporta una transacción abierta por
+// cualquiera de los TransactionManager de este paquete.
+func InTransaction(ctx context.Context) bool {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok && tx != nil {
+		return true
+	}
+	inMemory, _ := ctx.Value(memoryTxKey{}).(bool)
+	return inMemory
+}
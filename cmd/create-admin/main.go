@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/carloscacb333/go-hexagonal/app/bootstrap"
+	"github.com/carloscacb333/go-hexagonal/app/contexts/users/application/commands"
+	"github.com/carloscacb333/go-hexagonal/app/shared/domain/authorization"
+)
+
+const usage = "usage: ADMIN_PASSWORD=<password> create-admin -email=<email> -name=<name> [-tenant=platform] [-role=platform_admin|tenant_admin]"
+
+// Uso: ADMIN_PASSWORD=... create-admin -email=ops@example.com -name=Ops
+//
+// La contraseña se lee de ADMIN_PASSWORD para que no quede en el historial
+// de la shell ni en la lista de procesos.
+func main() {
+	flags := flag.NewFlagSet("create-admin", flag.ExitOnError)
+	tenantID := flags.String("tenant", "platform", "tenant the admin belongs to")
+	email := flags.String("email", "", "admin email")
+	name := flags.String("name", "", "admin name")
+	role := flags.String("role", string(authorization.RolePlatformAdmin), "platform_admin or tenant_admin")
+	flags.Parse(os.Args[1:])
+
+	password := os.Getenv("ADMIN_PASSWORD")
+	if *email == "" || *name == "" || password == "" {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	userID, err := bootstrap.CreateAdmin(ctx, commands.CreateAdminCommand{
+		TenantID: *tenantID,
+		Name:     *name,
+		Email:    *email,
+		Password: password,
+		Role:     authorization.Role(*role),
+	})
+	if err != nil {
+		log.Fatalf("Failed to create admin: %v", err)
+	}
+
+	fmt.Printf("created %s %s in tenant %s\n", *role, userID, *tenantID)
+}
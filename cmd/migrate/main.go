@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/carloscacb333/go-hexagonal/app/bootstrap"
+	"github.com/carloscacb333/go-hexagonal/app/shared/infrastructure/persistence/migrations"
+)
+
+const usage = "usage: migrate (up | down [-steps=<n>] | status) [-tenant=<id>]"
+
+// Uso: migrate up | migrate down [-steps=1] | migrate status
+//
+// Con tenancy por esquema o por base de datos cada comando se aplica a todos
+// los tenants, o solo al indicado con -tenant.
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	flags := flag.NewFlagSet("migrate "+command, flag.ExitOnError)
+	steps := flags.Int("steps", 1, "migrations to roll back with down")
+	tenant := flags.String("tenant", "", "only migrate this tenant's schema or database")
+	flags.Parse(os.Args[2:])
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	migrators, err := bootstrap.NewMigrators(ctx)
+	if err != nil {
+		log.Fatalf("Failed to initialize migrator: %v", err)
+	}
+
+	tenants := make([]string, 0, len(migrators))
+	for tenantID := range migrators {
+		if *tenant == "" || tenantID == *tenant {
+			tenants = append(tenants, tenantID)
+		}
+	}
+	if len(tenants) == 0 {
+		log.Fatalf("Unknown tenant %q", *tenant)
+	}
+	sort.Strings(tenants)
+
+	for _, tenantID := range tenants {
+		if tenantID != "" {
+			fmt.Printf("== tenant %s\n", tenantID)
+		} else if len(tenants) > 1 {
+			fmt.Println("== control database")
+		}
+		run(ctx, command, migrators[tenantID], *steps)
+	}
+}
+
+func run(ctx context.Context, command string, migrator *migrations.Migrator, steps int) {
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied  %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+
+	case "down":
+		if steps <= 0 {
+			log.Fatalf("steps must be positive")
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalf("Rollback failed: %v", err)
+		}
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read migration status: %v", err)
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-30s %s\n", status.Version, status.Name, state)
+		}
+		fmt.Printf("expected version: %d\n", migrator.Latest())
+
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/carloscacb333/go-hexagonal/app/bootstrap"
+)
+
+// Uso: rebuild-projection users_read [-tenant=<tenant>] [-batch-size=500]
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: rebuild-projection <projection> [-tenant=<tenant>] [-batch-size=<n>]")
+		os.Exit(2)
+	}
+	projection := os.Args[1]
+
+	flags := flag.NewFlagSet("rebuild-projection", flag.ExitOnError)
+	tenantID := flags.String("tenant", "", "rebuild only this tenant's rows")
+	batchSize := flags.Int("batch-size", 500, "rows read from the write model per batch")
+	flags.Parse(os.Args[2:])
+
+	if *batchSize <= 0 {
+		log.Fatalf("batch-size must be positive")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := bootstrap.RebuildProjection(ctx, projection, *tenantID, *batchSize); err != nil {
+		log.Fatalf("Rebuild failed: %v", err)
+	}
+}
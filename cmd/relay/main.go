@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/carloscacb333/go-hexagonal/app/bootstrap"
+	"go.uber.org/zap"
+)
+
+func main() {
+
+	app, err := bootstrap.NewApplication()
+	if err != nil {
+		log.Fatalf("Failed to initialize application: %v", err)
+	}
+
+	logger := app.GetLogger()
+	defer func() {
+		if err := logger.Sync(); err != nil {
+			log.Printf("Failed to sync logger: %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	relayErrors := make(chan error, 1)
+
+	// Métricas del outbox (outbox_lag_seconds, outbox_pending_messages, ...)
+	metricsServer := &http.Server{
+		Addr:              ":" + app.GetConfig().Outbox.MetricsPort,
+		Handler:           expvar.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		logger.Info("starting metrics server", zap.String("address", metricsServer.Addr))
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			relayErrors <- err
+		}
+	}()
+
+	go func() {
+		if err := app.StartOutboxRelay(ctx); err != nil {
+			relayErrors <- err
+		}
+	}()
+
+	shutdown := make(chan os.Signal, 1)
+	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+
+	select {
+	case err := <-relayErrors:
+		logger.Fatal("relay error", zap.Error(err))
+
+	case sig := <-shutdown:
+		logger.Info("received shutdown signal",
+			zap.String("signal", sig.String()),
+		)
+
+		cancel()
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer shutdownCancel()
+
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			logger.Error("error shutting down metrics server", zap.Error(err))
+		}
+
+		if err := app.Shutdown(shutdownCtx); err != nil {
+			logger.Error("error during shutdown", zap.Error(err))
+			os.Exit(1)
+		}
+
+		logger.Info("relay stopped gracefully")
+	}
+}
@@ -0,0 +1,37 @@
+#!/bin/sh
+# Crea los roles con los que conectan los procesos. Solo corre al inicializar
+# el volumen de datos: con un volumen existente hay que recrearlo.
+#
+# - DB_SYSTEM_USER: dueño de las tablas y con BYPASSRLS. Lo usan las
+#   migraciones y rebuild-projection.
+# - DB_USER: sin superusuario, sin BYPASSRLS y sin ser dueño de nada. Lo usan
+#   la API, los consumers y el relay; row-level security siempre le aplica.
+set -eu
+
+psql -v ON_ERROR_STOP=1 \
+	--username "$POSTGRES_USER" \
+	--dbname "$POSTGRES_DB" \
+	--set system_role="$DB_SYSTEM_USER" \
+	--set system_password="$DB_SYSTEM_PASSWORD" \
+	--set app_role="$DB_USER" \
+	--set app_password="$DB_PASSWORD" \
+	--set db_name="$POSTGRES_DB" <<-'EOSQL'
+	CREATE ROLE :"system_role" LOGIN BYPASSRLS PASSWORD :'system_password';
+	CREATE ROLE :"app_role" LOGIN NOSUPERUSER NOBYPASSRLS PASSWORD :'app_password';
+
+	-- El rol de sistema crea las tablas y los esquemas de tenant
+	GRANT CREATE ON DATABASE :"db_name" TO :"system_role";
+	ALTER SCHEMA public OWNER TO :"system_role";
+
+	GRANT CONNECT ON DATABASE :"db_name" TO :"app_role";
+	GRANT USAGE ON SCHEMA public TO :"app_role";
+
+	-- Todo lo que creen las migraciones queda accesible para la aplicación,
+	-- sin que sea su dueña
+	ALTER DEFAULT PRIVILEGES FOR ROLE :"system_role"
+		GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO :"app_role";
+	ALTER DEFAULT PRIVILEGES FOR ROLE :"system_role"
+		GRANT USAGE, SELECT ON SEQUENCES TO :"app_role";
+	ALTER DEFAULT PRIVILEGES FOR ROLE :"system_role"
+		GRANT USAGE ON SCHEMAS TO :"app_role";
+EOSQL